@@ -45,6 +45,18 @@ var ErrComputeOffsetZero = fmt.Errorf("Cannot compute offset from zero-length li
 // ErrWrongLink ...
 var ErrWrongLink = fmt.Errorf("Cannot link lines")
 
+// ErrNotInvertible ...
+var ErrNotInvertible = fmt.Errorf("Transformation is not invertible")
+
+// ErrWrongControlPoints ...
+var ErrWrongControlPoints = fmt.Errorf("The source and target control points should be paired and enough for the transformation")
+
+// ErrDegenerateControlPoints ...
+var ErrDegenerateControlPoints = fmt.Errorf("The control points are degenerate for the transformation, e.g. collinear")
+
+// ErrWrongBoundaryNodeRule ...
+var ErrWrongBoundaryNodeRule = fmt.Errorf("Boundary node rule is unknown")
+
 // ErrBoundBeNil ...
 var ErrBoundBeNil = fmt.Errorf("boundary should be nil")
 
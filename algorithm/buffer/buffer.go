@@ -3,6 +3,7 @@ package buffer
 
 import (
 	"log"
+	"math"
 
 	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/algorithm/matrix"
@@ -20,12 +21,28 @@ type ComputerBuffer struct {
 // Each offset curve has an attached  indicating
 // its left and right location.
 func Buffer(geom matrix.Steric, distance float64, quadrantSegments int) matrix.Steric {
+	param := DefaultCurveParameters()
+	param.QuadrantSegments = quadrantSegments
+	return BufferWithParams(geom, distance, param)
+}
+
+// BufferWithParams Computes the set of raw offset curves for the buffer with the parameters,
+// which specify the end cap style, the join style, the mitre limit and whether the buffer is single sided.
+// For a single sided buffer of line, a positive distance indicates the left side,
+// a negative distance indicates the right side.
+func BufferWithParams(geom matrix.Steric, distance float64, param *CurveParameters) matrix.Steric {
+	if param == nil {
+		param = DefaultCurveParameters()
+	}
 	eb := ComputerBuffer{}
-	eb.param = DefaultCurveParameters()
-	eb.param.QuadrantSegments = quadrantSegments
+	eb.param = param
 	eb.distance = distance
+	curveDistance := distance
+	if param.IsSingleSided {
+		curveDistance = math.Abs(distance)
+	}
 	eb.CurveBuilder = &CurveBuilder{
-		Curve: CurveWithParameters(eb.param, eb.distance),
+		Curve: CurveWithParameters(eb.param, curveDistance),
 	}
 
 	eb.Add(geom)
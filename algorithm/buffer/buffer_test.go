@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/spatial-go/geoos"
+	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
@@ -145,3 +146,62 @@ func TestBuffer(t *testing.T) {
 		})
 	}
 }
+
+func TestBufferWithParams(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	type args struct {
+		geom     matrix.Steric
+		distance float64
+		param    *CurveParameters
+	}
+	tests := []struct {
+		name string
+		args args
+		want matrix.Steric
+	}{
+		{name: "flat cap bevel join", args: args{
+			geom:     line,
+			distance: 1,
+			param:    &CurveParameters{8, calc.CapFlat, calc.JoinBevel, 5, 0.01, false},
+		}, want: matrix.PolygonMatrix{{{9, 1}, {9, 10}, {11, 10}, {11, 0}, {10, -1}, {0, -1}, {0, 1}, {9, 1}}},
+		},
+		{name: "square cap mitre join", args: args{
+			geom:     line,
+			distance: 1,
+			param:    &CurveParameters{8, calc.CapSquare, calc.JoinMitre, 5, 0.01, false},
+		}, want: matrix.PolygonMatrix{{{9, 1}, {9, 10}, {9, 11}, {11, 11}, {11, -1}, {0, -1}, {-1, -1}, {-1, 1}, {9, 1}}},
+		},
+		{name: "limited mitre join", args: args{
+			geom:     line,
+			distance: 1,
+			param:    &CurveParameters{8, calc.CapFlat, calc.JoinMitre, 1.2, 0.01, false},
+		}, want: matrix.PolygonMatrix{{{9, 1}, {9, 10}, {11, 10}, {10.955634918610405, -0.7414213562373093},
+			{10.741421356237309, -0.9556349186104045}, {0, -1}, {0, 1}, {9, 1}}},
+		},
+		{name: "single sided left", args: args{
+			geom:     line,
+			distance: 1,
+			param:    &CurveParameters{8, calc.CapRound, calc.JoinMitre, 5, 0.01, true},
+		}, want: matrix.PolygonMatrix{{{10, 10}, {10, 0}, {0, 0}, {0, 1}, {9, 1}, {9, 10}, {10, 10}}},
+		},
+		{name: "single sided right", args: args{
+			geom:     line,
+			distance: -1,
+			param:    &CurveParameters{8, calc.CapRound, calc.JoinMitre, 5, 0.01, true},
+		}, want: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {11, 10}, {11, -1}, {0, -1}, {0, 0}}},
+		},
+		{name: "polygon mitre join", args: args{
+			geom:     matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+			distance: 1,
+			param:    &CurveParameters{8, calc.CapRound, calc.JoinMitre, 5, 0.01, false},
+		}, want: matrix.PolygonMatrix{{{-1, -1}, {11, -1}, {11, 11}, {-1, 11}, {-1, -1}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BufferWithParams(tt.args.geom, tt.args.distance, tt.args.param); got == nil || !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("BufferWithParams() = %v,\n want %v", got, tt.want)
+			}
+		})
+	}
+}
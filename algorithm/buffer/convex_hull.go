@@ -320,63 +320,7 @@ func (r *RadialComparator) polarCompare(o, p, q matrix.Matrix) bool {
 }
 
 // OrientationIndex Returns the index of the direction of the point q relative to
-// a vector specified by p1-p2.
+// a vector specified by p1-p2, which is computed robustly by calc.OrientationIndex.
 func OrientationIndex(p1, p2, q matrix.Matrix) int {
-
-	// fast filter for orientation index
-	// avoids use of slow extended-precision arithmetic in many cases
-	index := orientationIndexFilter(p1[0], p1[1], p2[0], p2[1], q[0], q[1])
-	if index <= 1 {
-		return index
-	}
-	// normalize matrixes
-	dx1 := calc.ValueOf(p2[0]).SelfAddOne(-p1[0])
-	dy1 := calc.ValueOf(p2[1]).SelfAddOne(-p1[1])
-	dx2 := calc.ValueOf(q[0]).SelfAddOne(-p2[0])
-	dy2 := calc.ValueOf(q[1]).SelfAddOne(-p2[1])
-
-	dyx := dy1.SelfMultiplyPair(dx2)
-	// sign of determinant - unrolled for performance
-	return dx1.SelfMultiplyPair(dy2).SelfSubtract(dyx.Hi, dyx.Lo).Signum()
-}
-
-// orientationIndexFilter A filter for computing the orientation index of three matrixes.
-func orientationIndexFilter(pax, pay,
-	pbx, pby, pcx, pcy float64) int {
-	detSum := 0.0
-
-	detLeft := (pax - pcx) * (pby - pcy)
-	detRight := (pay - pcy) * (pbx - pcx)
-	det := detLeft - detRight
-
-	if detLeft > 0.0 {
-		if detRight <= 0.0 {
-			return signum(det)
-		}
-		detSum = detLeft + detRight
-	} else if detLeft < 0.0 {
-		if detRight >= 0.0 {
-			return signum(det)
-		}
-		detSum = -detLeft - detRight
-	} else {
-		return signum(det)
-	}
-
-	errbound := SafeEpsilon * detSum
-	if (det >= errbound) || (-det >= errbound) {
-		return signum(det)
-	}
-
-	return 2
-}
-
-func signum(x float64) int {
-	if x > 0 {
-		return 1
-	}
-	if x < 0 {
-		return -1
-	}
-	return 0
+	return calc.OrientationIndex(p1[0], p1[1], p2[0], p2[1], q[0], q[1])
 }
@@ -147,7 +147,7 @@ func (c *Curve) AddLineEndCap(p0, p1 matrix.Matrix, distance float64) {
 		c.Add(offsetR.P1)
 	case calc.CapSquare:
 		// add a square defined by extensions of the offset segment endpoints
-		squareCapSideOffset := matrix.Matrix{}
+		squareCapSideOffset := matrix.Matrix{0, 0}
 		squareCapSideOffset[0] = math.Abs(distance) * math.Cos(angle)
 		squareCapSideOffset[1] = math.Abs(distance) * math.Sin(angle)
 
@@ -405,10 +405,10 @@ func (c *Curve) addMitreJoin(p matrix.Matrix,
 	// This computation is unstable if the offset segments are nearly collinear.
 	// However, this situation should have been eliminated earlier by the check
 	// for whether the offset segment endpoints are almost coincident
-	_, intPt := operation.FindIntersection(c.offset0.P0, c.offset0.P1, offset1.P0, offset1.P1)
+	_, intPt := operation.FindIntersection(offset0.P0, offset0.P1, offset1.P0, offset1.P1)
 	if intPt != nil {
 		mitreRatio := 1.0
-		if distance > 0.0 {
+		if distance != 0.0 {
 			mitreRatio = measure.PlanarDistance(intPt[0].Matrix, p) / math.Abs(distance)
 		}
 		if mitreRatio <= c.parameters.MitreLimit {
@@ -416,8 +416,8 @@ func (c *Curve) addMitreJoin(p matrix.Matrix,
 			return
 		}
 	}
-	// at this point either intersection failed or mitre limit was exceeded
-	c.addLimitedMitreJoin(offset0, offset1, distance, c.parameters.MitreLimit)
+	// at this point either the offset segments don't meet or mitre limit was exceeded
+	c.addLimitedMitreJoin(offset0, offset1, math.Abs(distance), c.parameters.MitreLimit)
 	//      addBevelJoin(offset0, offset1);
 }
 
@@ -441,6 +441,14 @@ func (c *Curve) addLimitedMitreJoin(
 	// rotating this by PI gives the bisector of the reflex angle
 	mitreMidAng := angle.Normalize(midAng + math.Pi)
 
+	// the mitre of the offset lines is at distance / sin(angDiffHalf) from the corner,
+	// it is added if the mitre limit isn't exceeded.
+	if mitreRatio := 1 / math.Abs(math.Sin(angDiffHalf)); mitreRatio <= mitreLimit {
+		c.Add(matrix.Matrix{basePt[0] + mitreRatio*distance*math.Cos(mitreMidAng),
+			basePt[1] + mitreRatio*distance*math.Sin(mitreMidAng)})
+		return
+	}
+
 	// the miterLimit determines the distance to the mitre bevel
 	mitreDist := mitreLimit * distance
 	// the bevel delta is the difference between the buffer distance
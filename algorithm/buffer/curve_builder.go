@@ -28,6 +28,7 @@ func (c *CurveBuilder) LineCurve(pts matrix.LineMatrix, distance float64,
 	} else {
 		if c.parameters.IsSingleSided {
 			isRightSide := distance < 0.0
+			c.distance = math.Abs(distance)
 			c.computeSingleSidedBufferCurve(pts, isRightSide)
 		} else {
 			c.computeLineBufferCurve(pts)
@@ -196,13 +197,17 @@ func (c *CurveBuilder) computeSingleSidedBufferCurve(pts matrix.LineMatrix, isRi
 
 		// since we are traversing line in opposite order, offset position is still LEFT
 		c.Curve.initSideSegments(simp2[n2], simp2[n2-1], calc.SideLeft)
-		c.Curve.Add(c.Curve.offset1.P1)
+		c.Curve.Add(c.Curve.offset1.P0)
 		for i := n2 - 2; i >= 0; i-- {
 			c.Curve.addNextSegment(simp2[i], true)
 		}
 	} else {
-		// add original line
-		c.Curve.AddLine(pts)
+		// add original line in reverse order
+		reversed := make(matrix.LineMatrix, 0, len(pts))
+		for i := len(pts) - 1; i >= 0; i-- {
+			reversed = append(reversed, pts[i])
+		}
+		c.Curve.AddLine(reversed)
 
 		//--------- compute points for left side of line
 		// Simplify the appropriate side of the line before generating
@@ -213,7 +218,7 @@ func (c *CurveBuilder) computeSingleSidedBufferCurve(pts matrix.LineMatrix, isRi
 		//      Coordinate[] simp1 = inputPts;
 		n1 := len(simp1) - 1
 		c.Curve.initSideSegments(simp1[0], simp1[1], calc.SideLeft)
-		c.Curve.Add(c.Curve.offset1.P1)
+		c.Curve.Add(c.Curve.offset1.P0)
 		for i := 2; i <= n1; i++ {
 			c.Curve.addNextSegment(simp1[i], true)
 		}
@@ -0,0 +1,236 @@
+package buffer
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/operation"
+)
+
+// MaximumInscribedCircle computes the largest circle contained in the polygonal geometry,
+// its centre is the pole of inaccessibility, which is the interior point farthest from the boundary.
+// The centre is found by the grid cells search of polylabel, the radius is accurate within tolerance.
+// The centre is nil if the geometry has no polygon.
+func MaximumInscribedCircle(geom matrix.Steric, tolerance float64) (centre matrix.Matrix, radius float64) {
+	polys := polygonalParts(geom)
+	if len(polys) == 0 {
+		return nil, 0
+	}
+	rings := []matrix.LineMatrix{}
+	for _, poly := range polys {
+		for _, ring := range poly {
+			rings = append(rings, ring)
+		}
+	}
+	// the distance is positive inside the polygons and negative outside.
+	distance := func(p matrix.Matrix) float64 {
+		d := distanceToLines(p, rings)
+		for _, poly := range polys {
+			if inPolygon(p, poly) {
+				return d
+			}
+		}
+		return -d
+	}
+	cell := searchCells(geom.Bound(), tolerance, distance, func(matrix.Matrix, float64) bool { return true })
+	return cell.centre, math.Max(cell.distance, 0)
+}
+
+// LargestEmptyCircle computes the largest circle whose interior does not intersect the obstacles,
+// the centre of circle is in the boundary polygon, it is the convex hull of obstacles if the boundary is nil.
+// The radius is accurate within tolerance. The first point of obstacles is the centre with zero radius
+// if the boundary has no area, e.g. the obstacles are collinear.
+func LargestEmptyCircle(obstacles, boundary matrix.Steric, tolerance float64) (centre matrix.Matrix, radius float64) {
+	if boundary == nil {
+		boundary = ConvexHull(obstacles)
+	}
+	polys := polygonalParts(boundary)
+	pts := matrix.TransMatrixes(obstacles)
+	if len(pts) == 0 {
+		return nil, 0
+	}
+	if len(polys) == 0 {
+		return pts[0], 0
+	}
+	lines := obstacleLines(obstacles)
+	rings := []matrix.LineMatrix{}
+	for _, poly := range polys {
+		for _, ring := range poly {
+			rings = append(rings, ring)
+		}
+	}
+	distance := func(p matrix.Matrix) float64 {
+		return distanceToLines(p, lines)
+	}
+	// the cell may contain a centre if it is not farther from the boundary polygons than its half diagonal.
+	inBoundary := func(p matrix.Matrix, halfDiagonal float64) bool {
+		for _, poly := range polys {
+			if inPolygon(p, poly) {
+				return true
+			}
+		}
+		return halfDiagonal > 0 && distanceToLines(p, rings) <= halfDiagonal
+	}
+	cell := searchCells(boundary.Bound(), tolerance, distance, inBoundary)
+	if cell.centre == nil {
+		return pts[0], 0
+	}
+	return cell.centre, cell.distance
+}
+
+// circleCell is the square cell of grid search.
+type circleCell struct {
+	centre   matrix.Matrix
+	half     float64
+	distance float64
+	max      float64
+}
+
+// searchCells finds the point in the bound which has the largest distance.
+// The cells are split in the order of the largest possible distance in them,
+// until the possible distance is not larger than the found distance by tolerance.
+// The points which are not valid are not returned, and the cells which are not valid are discarded.
+func searchCells(bound matrix.Bound, tolerance float64,
+	distance func(p matrix.Matrix) float64, valid func(p matrix.Matrix, halfDiagonal float64) bool) *circleCell {
+	width, height := bound[1][0]-bound[0][0], bound[1][1]-bound[0][1]
+	cellSize := math.Min(width, height)
+	if cellSize == 0 {
+		cellSize = math.Max(width, height)
+	}
+	tolerance = math.Max(tolerance, cellSize*calc.DefaultTolerance)
+
+	queue := &cellQueue{}
+	best := &circleCell{distance: math.Inf(-1)}
+	add := func(x, y, half float64) {
+		cell := &circleCell{centre: matrix.Matrix{x, y}, half: half}
+		halfDiagonal := half * math.Sqrt2
+		if !valid(cell.centre, halfDiagonal) {
+			return
+		}
+		cell.distance = distance(cell.centre)
+		cell.max = cell.distance + halfDiagonal
+		if cell.distance > best.distance && valid(cell.centre, 0) {
+			best = cell
+		}
+		heap.Push(queue, cell)
+	}
+	if cellSize == 0 {
+		add(bound[0][0], bound[0][1], 0)
+		return best
+	}
+
+	add(bound[0][0]+width/2, bound[0][1]+height/2, 0)
+	half := cellSize / 2
+	for x := bound[0][0]; x < bound[1][0]; x += cellSize {
+		for y := bound[0][1]; y < bound[1][1]; y += cellSize {
+			add(x+half, y+half, half)
+		}
+	}
+	for queue.Len() > 0 {
+		cell := heap.Pop(queue).(*circleCell)
+		if cell.max-best.distance <= tolerance {
+			break
+		}
+		h := cell.half / 2
+		add(cell.centre[0]-h, cell.centre[1]-h, h)
+		add(cell.centre[0]+h, cell.centre[1]-h, h)
+		add(cell.centre[0]-h, cell.centre[1]+h, h)
+		add(cell.centre[0]+h, cell.centre[1]+h, h)
+	}
+	return best
+}
+
+// polygonalParts returns the polygons of geometry.
+func polygonalParts(geom matrix.Steric) []matrix.PolygonMatrix {
+	switch m := geom.(type) {
+	case matrix.PolygonMatrix:
+		if len(m) > 0 {
+			return []matrix.PolygonMatrix{m}
+		}
+	case matrix.MultiPolygonMatrix:
+		polys := []matrix.PolygonMatrix{}
+		for _, v := range m {
+			polys = append(polys, v)
+		}
+		return polys
+	case matrix.Collection:
+		polys := []matrix.PolygonMatrix{}
+		for _, v := range m {
+			polys = append(polys, polygonalParts(v)...)
+		}
+		return polys
+	}
+	return nil
+}
+
+// obstacleLines returns the points, lines and rings of geometry as lines.
+func obstacleLines(geom matrix.Steric) []matrix.LineMatrix {
+	switch m := geom.(type) {
+	case matrix.Matrix:
+		return []matrix.LineMatrix{{m}}
+	case matrix.LineMatrix:
+		return []matrix.LineMatrix{m}
+	case matrix.PolygonMatrix:
+		lines := []matrix.LineMatrix{}
+		for _, ring := range m {
+			lines = append(lines, ring)
+		}
+		return lines
+	case matrix.MultiPolygonMatrix:
+		lines := []matrix.LineMatrix{}
+		for _, poly := range m {
+			lines = append(lines, obstacleLines(matrix.PolygonMatrix(poly))...)
+		}
+		return lines
+	case matrix.Collection:
+		lines := []matrix.LineMatrix{}
+		for _, v := range m {
+			lines = append(lines, obstacleLines(v)...)
+		}
+		return lines
+	}
+	return nil
+}
+
+// distanceToLines returns the smallest distance from the point to the lines.
+func distanceToLines(p matrix.Matrix, lines []matrix.LineMatrix) float64 {
+	dist := math.Inf(1)
+	for _, line := range lines {
+		if len(line) == 1 {
+			dist = math.Min(dist, math.Hypot(p[0]-line[0][0], p[1]-line[0][1]))
+			continue
+		}
+		dist = math.Min(dist, measure.PlanarDistance(p, line))
+	}
+	return dist
+}
+
+// inPolygon returns true if the point is inside the shell and outside the holes of polygon.
+func inPolygon(p matrix.Matrix, poly matrix.PolygonMatrix) bool {
+	if !operation.IsPnPolygon(p, poly[0]) {
+		return false
+	}
+	for _, hole := range poly[1:] {
+		if operation.IsPnPolygon(p, hole) {
+			return false
+		}
+	}
+	return true
+}
+
+// cellQueue is the priority queue of cells, the cell of largest possible distance is first.
+type cellQueue []*circleCell
+
+func (q cellQueue) Len() int            { return len(q) }
+func (q cellQueue) Less(i, j int) bool  { return q[i].max > q[j].max }
+func (q cellQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *cellQueue) Push(x interface{}) { *q = append(*q, x.(*circleCell)) }
+func (q *cellQueue) Pop() interface{} {
+	old := *q
+	cell := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return cell
+}
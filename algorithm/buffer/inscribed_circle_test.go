@@ -0,0 +1,64 @@
+package buffer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestMaximumInscribedCircle(t *testing.T) {
+	tests := []struct {
+		name       string
+		geom       matrix.Steric
+		wantCentre matrix.Matrix
+		wantRadius float64
+	}{
+		{"square", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}, matrix.Matrix{5, 5}, 5},
+		{"L shape", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 2}, {2, 2}, {2, 10}, {0, 10}, {0, 0}}},
+			matrix.Matrix{2 * math.Sqrt2 / (1 + math.Sqrt2), 2 * math.Sqrt2 / (1 + math.Sqrt2)}, 2 * math.Sqrt2 / (1 + math.Sqrt2)},
+		{"hole", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}},
+			matrix.Matrix{7.657, 7.657}, 2.343},
+		{"line", matrix.LineMatrix{{0, 0}, {1, 1}}, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			centre, radius := MaximumInscribedCircle(tt.geom, 0.001)
+			if tt.wantCentre == nil {
+				if centre != nil {
+					t.Errorf("MaximumInscribedCircle() = %v, want nil", centre)
+				}
+				return
+			}
+			if math.Abs(radius-tt.wantRadius) > 0.001 ||
+				math.Hypot(centre[0]-tt.wantCentre[0], centre[1]-tt.wantCentre[1]) > 0.01 {
+				t.Errorf("MaximumInscribedCircle() = %v %v, want %v %v", centre, radius, tt.wantCentre, tt.wantRadius)
+			}
+		})
+	}
+}
+
+func TestLargestEmptyCircle(t *testing.T) {
+	square := matrix.Collection{matrix.Matrix{0, 0}, matrix.Matrix{10, 0}, matrix.Matrix{10, 10}, matrix.Matrix{0, 10}}
+	tests := []struct {
+		name       string
+		obstacles  matrix.Steric
+		boundary   matrix.Steric
+		wantCentre matrix.Matrix
+		wantRadius float64
+	}{
+		{"square", square, nil, matrix.Matrix{5, 5}, math.Sqrt(50)},
+		{"boundary", square, matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}, matrix.Matrix{4, 4}, math.Sqrt(32)},
+		{"line", matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 3}, {0, 3}, {0, 0}}}, nil, 3},
+		{"collinear", matrix.LineMatrix{{0, 0}, {1, 1}}, nil, matrix.Matrix{0, 0}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			centre, radius := LargestEmptyCircle(tt.obstacles, tt.boundary, 0.001)
+			if math.Abs(radius-tt.wantRadius) > 0.001 ||
+				(tt.wantCentre != nil && math.Hypot(centre[0]-tt.wantCentre[0], centre[1]-tt.wantCentre[1]) > 0.01) {
+				t.Errorf("LargestEmptyCircle() = %v %v, want %v %v", centre, radius, tt.wantCentre, tt.wantRadius)
+			}
+		})
+	}
+}
@@ -21,6 +21,10 @@ func TestInteriorPoint(t *testing.T) {
 			{{0, 0}, {0, 5}, {5, 5}, {5, 0}, {0, 0}},
 		},
 		}, matrix.Matrix{2.5, 2.5}},
+		{"polygon counterclockwise interior", args{matrix.PolygonMatrix{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		},
+		}, matrix.Matrix{5, 5}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2,7 +2,6 @@ package buffer
 
 import (
 	"math"
-	"math/big"
 
 	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/algorithm/matrix"
@@ -155,52 +154,5 @@ func (l *LineSimplifier) isConcave(p0, p1, p2 matrix.Matrix) bool {
 func (l *LineSimplifier) orientationIndex(p1x, p1y,
 	p2x, p2y,
 	qx, qy float64) int {
-	// fast filter for orientation index
-	// avoids use of slow extended-precision arithmetic in many cases
-	index := l.orientationIndexFilter(p1x, p1y, p2x, p2y, qx, qy)
-	if index <= 1 {
-		return index
-	}
-	// // normalize coordinates
-	// dx1 := (&calc.PairFloat{Hi: p2x, Lo: 0.0}).SelfAdd(-p1x, 0.0)
-	// dy1 := (&calc.PairFloat{Hi: p2y, Lo: 0.0}).SelfAdd(-p1y, 0.0)
-	// dx2 := (&calc.PairFloat{Hi: qx, Lo: 0.0}).SelfAdd(-p2x, 0.0)
-	// dy2 := (&calc.PairFloat{Hi: qy, Lo: 0.0}).SelfAdd(-p2y, 0.0)
-	// dy11 := dy1.SelfMultiply(dx2.Hi, dx2.Lo)
-
-	// // sign of determinant - unrolled for performance
-	// return dx1.SelfMultiply(dy2.Hi, dy2.Lo).SelfSubtract(dy11.Hi, dy11.Lo).Signum()
-
-	return new(big.Float).SetFloat64((p2x-p1x)*(qy-p2y) - (p2y-p1y)*(qx-p2x)).Sign()
-}
-
-// orientationIndexFilter A filter for computing the orientation index of three coordinates.
-func (l *LineSimplifier) orientationIndexFilter(pax, pay,
-	pbx, pby, pcx, pcy float64) int {
-	detSum := 0.0
-
-	detLeft := (pax - pcx) * (pby - pcy)
-	detRight := (pay - pcy) * (pbx - pcx)
-	det := detLeft - detRight
-
-	if detLeft > 0.0 {
-		if detRight <= 0.0 {
-			return calc.Signum(det)
-		}
-		detSum = detLeft + detRight
-	} else if detLeft < 0.0 {
-		if detRight >= 0.0 {
-			return calc.Signum(det)
-		}
-		detSum = -detLeft - detRight
-	} else {
-		return calc.Signum(det)
-	}
-
-	errbound := SafeEpsilon * detSum
-	if (det >= errbound) || (-det >= errbound) {
-		return calc.Signum(det)
-	}
-
-	return 2
+	return calc.OrientationIndex(p1x, p1y, p2x, p2y, qx, qy)
 }
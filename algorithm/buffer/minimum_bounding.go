@@ -0,0 +1,217 @@
+package buffer
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// MinimumBoundingCircle computes the smallest circle which contains all the points of geometry,
+// the radius is zero if the geometry is a point and the centre is nil if the geometry is empty.
+// The circle is computed from the points of convex hull.
+func MinimumBoundingCircle(geom matrix.Steric) (centre matrix.Matrix, radius float64) {
+	pts := hullPoints(geom)
+	if len(pts) == 0 {
+		return nil, 0
+	}
+	// the points are shuffled for the expected linear time, the seed is fixed for the same result of the same input.
+	random := rand.New(rand.NewSource(1))
+	random.Shuffle(len(pts), func(i, j int) {
+		pts[i], pts[j] = pts[j], pts[i]
+	})
+	inCircle := func(p matrix.Matrix) bool {
+		return math.Hypot(p[0]-centre[0], p[1]-centre[1]) <= radius*(1+calc.DefaultTolerance)
+	}
+	// the incremental algorithm of Welzl, the circle is defined by the points outside the current one.
+	centre, radius = pts[0], 0
+	for i := 1; i < len(pts); i++ {
+		if inCircle(pts[i]) {
+			continue
+		}
+		centre, radius = pts[i], 0
+		for j := 0; j < i; j++ {
+			if inCircle(pts[j]) {
+				continue
+			}
+			centre = matrix.Matrix{(pts[i][0] + pts[j][0]) / 2, (pts[i][1] + pts[j][1]) / 2}
+			radius = math.Hypot(pts[i][0]-centre[0], pts[i][1]-centre[1])
+			for k := 0; k < j; k++ {
+				if inCircle(pts[k]) {
+					continue
+				}
+				if c, ok := circumcentre(pts[i], pts[j], pts[k]); ok {
+					centre, radius = c, math.Hypot(pts[i][0]-c[0], pts[i][1]-c[1])
+				}
+			}
+		}
+	}
+	return centre, radius
+}
+
+// MinimumRotatedRectangle computes the rectangle of minimum area which contains all the points of geometry,
+// the rectangle may be rotated and one of its sides is collinear with an edge of convex hull.
+// The line is returned if the points are collinear and the point is returned if the points are identical.
+func MinimumRotatedRectangle(geom matrix.Steric) matrix.Steric {
+	hull := ConvexHull(geom)
+	pts := hullPoints(geom)
+	if len(pts) < 3 {
+		return hull
+	}
+	var result matrix.PolygonMatrix
+	minArea := math.Inf(1)
+	calipers := newRotatingCalipers(pts)
+	for i := range pts {
+		p0, u, v, ok := calipers.rotate(i)
+		if !ok {
+			continue
+		}
+		minU, maxU := calipers.minU(), calipers.maxU()
+		minV, maxV := math.Min(0, calipers.maxV()), math.Max(0, calipers.maxV())
+		if area := (maxU - minU) * (maxV - minV); area < minArea {
+			minArea = area
+			corner := func(a, b float64) []float64 {
+				return []float64{p0[0] + a*u[0] + b*v[0], p0[1] + a*u[1] + b*v[1]}
+			}
+			result = matrix.PolygonMatrix{{
+				corner(minU, minV), corner(maxU, minV), corner(maxU, maxV), corner(minU, maxV), corner(minU, minV),
+			}}
+		}
+	}
+	return result
+}
+
+// MinimumDiameter computes the minimum width of geometry, it is the smallest distance
+// between two parallel lines which contain all the points of geometry between them.
+// The line of width is returned, it is from a vertex of convex hull to the supporting line of its opposite edge.
+// The width is zero if the points are collinear, the line is nil if the geometry is empty.
+func MinimumDiameter(geom matrix.Steric) (width float64, line matrix.LineMatrix) {
+	pts := hullPoints(geom)
+	switch len(pts) {
+	case 0:
+		return 0, nil
+	case 1, 2:
+		return 0, matrix.LineMatrix{pts[0], pts[0]}
+	}
+	width = math.Inf(1)
+	calipers := newRotatingCalipers(pts)
+	for i := range pts {
+		p0, u, _, ok := calipers.rotate(i)
+		if !ok {
+			continue
+		}
+		// the farthest point from the edge gives the width in its direction.
+		if d := math.Abs(calipers.maxV()); d < width {
+			width = d
+			farthest := pts[calipers.far]
+			t := calipers.project(farthest, u)
+			line = matrix.LineMatrix{farthest, {p0[0] + t*u[0], p0[1] + t*u[1]}}
+		}
+	}
+	return width, line
+}
+
+// rotatingCalipers finds the extreme points of convex hull in the direction of its edges and their normals,
+// the indexes of extreme points only move forward along the hull while the edges are visited in order,
+// so all the edges are visited in linear time.
+type rotatingCalipers struct {
+	pts              []matrix.Matrix
+	p0, u, v         matrix.Matrix
+	front, far, back int
+}
+
+// newRotatingCalipers returns the calipers of the vertices of convex hull without the closing point.
+func newRotatingCalipers(pts []matrix.Matrix) *rotatingCalipers {
+	return &rotatingCalipers{pts: pts, front: -1, far: -1, back: -1}
+}
+
+// rotate sets the calipers on the edge from the point index to the next point,
+// returns the start point of edge, the unit direction of edge and its left normal,
+// returns false if the edge has zero length.
+func (r *rotatingCalipers) rotate(index int) (p0, u, v matrix.Matrix, ok bool) {
+	n := len(r.pts)
+	p0, p1 := r.pts[index], r.pts[(index+1)%n]
+	length := math.Hypot(p1[0]-p0[0], p1[1]-p0[1])
+	if length == 0 {
+		return nil, nil, nil, false
+	}
+	r.p0 = p0
+	r.u = matrix.Matrix{(p1[0] - p0[0]) / length, (p1[1] - p0[1]) / length}
+	r.v = matrix.Matrix{-r.u[1], r.u[0]}
+	// the extreme points follow the edge in the order of front, far side and back,
+	// they start from the end of the first edge, then from where they were for the previous edge.
+	if r.front < 0 {
+		r.front = (index + 1) % n
+	}
+	r.front = r.advance(r.front, func(p matrix.Matrix) float64 { return r.project(p, r.u) })
+	if r.far < 0 {
+		r.far = r.front
+	}
+	r.far = r.advance(r.far, func(p matrix.Matrix) float64 { return math.Abs(r.project(p, r.v)) })
+	if r.back < 0 {
+		r.back = r.far
+	}
+	r.back = r.advance(r.back, func(p matrix.Matrix) float64 { return -r.project(p, r.u) })
+	return r.p0, r.u, r.v, true
+}
+
+// advance moves the index forward along the hull while the next point is not smaller by f.
+func (r *rotatingCalipers) advance(index int, f func(p matrix.Matrix) float64) int {
+	n := len(r.pts)
+	for step := 0; step < n && f(r.pts[(index+1)%n]) >= f(r.pts[index]); step++ {
+		index = (index + 1) % n
+	}
+	return index
+}
+
+// project returns the coordinate of point along the direction from the start point of edge.
+func (r *rotatingCalipers) project(p, direction matrix.Matrix) float64 {
+	return (p[0]-r.p0[0])*direction[0] + (p[1]-r.p0[1])*direction[1]
+}
+
+// minU returns the smallest coordinate of hull along the edge.
+func (r *rotatingCalipers) minU() float64 {
+	return r.project(r.pts[r.back], r.u)
+}
+
+// maxU returns the largest coordinate of hull along the edge.
+func (r *rotatingCalipers) maxU() float64 {
+	return r.project(r.pts[r.front], r.u)
+}
+
+// maxV returns the coordinate of the farthest point of hull on the normal of edge,
+// it is negative if the hull is on the right of edge.
+func (r *rotatingCalipers) maxV() float64 {
+	return r.project(r.pts[r.far], r.v)
+}
+
+// hullPoints returns the vertices of convex hull without the closing point.
+func hullPoints(geom matrix.Steric) []matrix.Matrix {
+	switch hull := ConvexHull(geom).(type) {
+	case matrix.Matrix:
+		return []matrix.Matrix{hull}
+	case matrix.LineMatrix:
+		return []matrix.Matrix{hull[0], hull[len(hull)-1]}
+	case matrix.PolygonMatrix:
+		pts := make([]matrix.Matrix, 0, len(hull[0]))
+		for _, v := range hull[0][:len(hull[0])-1] {
+			pts = append(pts, v)
+		}
+		return pts
+	}
+	return nil
+}
+
+// circumcentre returns the centre of the circle through the three points,
+// returns false if the points are collinear.
+func circumcentre(a, b, c matrix.Matrix) (matrix.Matrix, bool) {
+	bx, by := b[0]-a[0], b[1]-a[1]
+	cx, cy := c[0]-a[0], c[1]-a[1]
+	d := 2 * (bx*cy - by*cx)
+	if d == 0 {
+		return nil, false
+	}
+	b2, c2 := bx*bx+by*by, cx*cx+cy*cy
+	return matrix.Matrix{a[0] + (cy*b2-by*c2)/d, a[1] + (bx*c2-cx*b2)/d}, true
+}
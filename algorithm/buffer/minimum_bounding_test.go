@@ -0,0 +1,101 @@
+package buffer
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// hexagon returns the regular hexagon whose vertices are at the distance 2 from the origin.
+func hexagon() matrix.PolygonMatrix {
+	ring := matrix.LineMatrix{}
+	for i := 0; i <= 6; i++ {
+		angle := float64(i%6) * math.Pi / 3
+		ring = append(ring, []float64{2 * math.Cos(angle), 2 * math.Sin(angle)})
+	}
+	return matrix.PolygonMatrix{ring}
+}
+
+func TestMinimumBoundingCircle(t *testing.T) {
+	tests := []struct {
+		name       string
+		geom       matrix.Steric
+		wantCentre matrix.Matrix
+		wantRadius float64
+	}{
+		{"point", matrix.Matrix{1, 1}, matrix.Matrix{1, 1}, 0},
+		{"line", matrix.LineMatrix{{0, 0}, {1, 1}, {4, 4}}, matrix.Matrix{2, 2}, math.Sqrt(8)},
+		{"square", matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}, matrix.Matrix{1, 1}, math.Sqrt2},
+		{"triangle", matrix.Collection{matrix.Matrix{0, 0}, matrix.Matrix{6, 0}, matrix.Matrix{3, 4}, matrix.Matrix{3, 1}},
+			matrix.Matrix{3, 0.875}, 3.125},
+		{"obtuse", matrix.Collection{matrix.Matrix{0, 0}, matrix.Matrix{10, 0}, matrix.Matrix{5, 1}},
+			matrix.Matrix{5, 0}, 5},
+		{"hexagon", hexagon(), matrix.Matrix{0, 0}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			centre, radius := MinimumBoundingCircle(tt.geom)
+			if !centre.Proximity(tt.wantCentre) || math.Abs(radius-tt.wantRadius) > 1e-9 {
+				t.Errorf("MinimumBoundingCircle() = %v %v, want %v %v", centre, radius, tt.wantCentre, tt.wantRadius)
+			}
+		})
+	}
+}
+
+func TestMinimumRotatedRectangle(t *testing.T) {
+	tests := []struct {
+		name     string
+		geom     matrix.Steric
+		want     matrix.Steric
+		wantArea float64
+	}{
+		{"point", matrix.Matrix{1, 1}, matrix.Matrix{1, 1}, 0},
+		{"line", matrix.LineMatrix{{0, 0}, {1, 1}, {2, 2}}, matrix.LineMatrix{{0, 0}, {2, 2}}, 0},
+		{"diamond", matrix.PolygonMatrix{{{0, 0}, {2, 2}, {1, 3}, {-1, 1}, {0, 0}}}, nil, 4},
+		{"triangle", matrix.PolygonMatrix{{{0, 0}, {4, 0}, {1, 2}, {0, 0}}}, nil, 8},
+		{"hexagon", hexagon(), nil, 8 * math.Sqrt(3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MinimumRotatedRectangle(tt.geom)
+			if tt.want != nil {
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("MinimumRotatedRectangle() = %v, want %v", got, tt.want)
+				}
+				return
+			}
+			if area := measure.AreaOfPolygon(got.(matrix.PolygonMatrix)); math.Abs(area-tt.wantArea) > 1e-9 {
+				t.Errorf("MinimumRotatedRectangle() area = %v, want %v", area, tt.wantArea)
+			}
+		})
+	}
+}
+
+func TestMinimumDiameter(t *testing.T) {
+	tests := []struct {
+		name      string
+		geom      matrix.Steric
+		wantWidth float64
+		wantLine  matrix.LineMatrix
+	}{
+		{"empty", matrix.Collection{}, 0, nil},
+		{"line", matrix.LineMatrix{{0, 0}, {2, 2}}, 0, matrix.LineMatrix{{0, 0}, {0, 0}}},
+		{"rectangle", matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 1}, {0, 1}, {0, 0}}}, 1, matrix.LineMatrix{{0, 1}, {0, 0}}},
+		{"diamond", matrix.PolygonMatrix{{{0, 0}, {2, 2}, {1, 3}, {-1, 1}, {0, 0}}}, math.Sqrt2, nil},
+		{"hexagon", hexagon(), 2 * math.Sqrt(3), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, line := MinimumDiameter(tt.geom)
+			if math.Abs(width-tt.wantWidth) > 1e-9 {
+				t.Errorf("MinimumDiameter() width = %v, want %v", width, tt.wantWidth)
+			}
+			if tt.wantLine != nil && !line.Proximity(tt.wantLine) {
+				t.Errorf("MinimumDiameter() line = %v, want %v", line, tt.wantLine)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package buffer
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/operation"
+	"github.com/spatial-go/geoos/algorithm/overlay/chain"
+	"github.com/spatial-go/geoos/index/intervalrtree"
+)
+
+// OffsetCurve Computes the offset curve of the line at the distance, the line is parallel to the input line.
+// A positive distance indicates the left side, a negative distance indicates the right side.
+// The corners are joined by the join style and the mitre limit of the parameters.
+// The offset curve is the part of the buffer boundary on the side of the line,
+// the raw offset curve is noded with the buffer curves and only its sections on the boundary are kept,
+// so the curve doesn't loop back at the inside turns or run into the buffer of other parts of the line.
+// It returns a line, a collection of lines if the offset curve is broken into sections,
+// or an empty line if no part of the buffer boundary is on the side.
+// A closed line produces a closed offset curve.
+func OffsetCurve(line matrix.LineMatrix, distance float64, param *CurveParameters) matrix.Steric {
+	pts := removeRepeatedPoints(line)
+	if len(pts) < 2 || distance == 0.0 {
+		return pts
+	}
+	if param == nil {
+		param = DefaultCurveParameters()
+	}
+
+	side := calc.SideLeft
+	if distance < 0.0 {
+		side = calc.SideRight
+	}
+	posDistance := math.Abs(distance)
+	isClosed := len(pts) > 3 && pts.IsClosed()
+
+	raw := newOffsetCurveBuilder(param, posDistance)
+	var outline []matrix.LineMatrix
+	if isClosed {
+		// the inverted ring curve is discarded as the buffer does, so the side eroded has no offset curve.
+		raw.computeRingBufferCurve(pts, side)
+		if raw.IsRingCurveInverted(pts, posDistance) {
+			return matrix.LineMatrix{}
+		}
+		otherSide := calc.SideLeft
+		if side == calc.SideLeft {
+			otherSide = calc.SideRight
+		}
+		other := newOffsetCurveBuilder(param, posDistance)
+		other.computeRingBufferCurve(pts, otherSide)
+		reversed := append(matrix.LineMatrix{}, raw.Curve.Line...)
+		switch {
+		case other.IsRingCurveInverted(pts, posDistance) && side == calc.SideLeft:
+			outline = []matrix.LineMatrix{raw.Curve.Line}
+		case other.IsRingCurveInverted(pts, posDistance):
+			outline = []matrix.LineMatrix{reversed.Reverse()}
+		case side == calc.SideLeft:
+			outline = []matrix.LineMatrix{raw.Curve.Line, other.Curve.Line.Reverse()}
+		default:
+			outline = []matrix.LineMatrix{other.Curve.Line, reversed.Reverse()}
+		}
+	} else {
+		raw.computeOffsetCurve(pts, side)
+		both := newOffsetCurveBuilder(param, posDistance)
+		both.computeLineBufferCurve(pts)
+		outline = []matrix.LineMatrix{both.Curve.Line}
+	}
+
+	sections := offsetSections(raw.Curve.Line, outline, side, posDistance)
+	sections = joinSections(sections, isClosed, posDistance*calc.DefaultTolerance6)
+	switch len(sections) {
+	case 0:
+		return matrix.LineMatrix{}
+	case 1:
+		return sections[0]
+	}
+	coll := make(matrix.Collection, 0, len(sections))
+	for _, v := range sections {
+		coll = append(coll, v)
+	}
+	return coll
+}
+
+// newOffsetCurveBuilder returns the builder of the raw curves at the distance.
+func newOffsetCurveBuilder(param *CurveParameters, distance float64) *CurveBuilder {
+	return &CurveBuilder{
+		Curve:    CurveWithParameters(param, distance),
+		distance: distance,
+	}
+}
+
+// computeOffsetCurve computes the offset curve of one side of the line.
+func (c *CurveBuilder) computeOffsetCurve(pts matrix.LineMatrix, side int) {
+	distTol := c.distance * c.parameters.SimplifyFactor
+	if side == calc.SideRight {
+		distTol = -distTol
+	}
+	simp := &LineSimplifier{inputLine: pts}
+	simp1 := simp.Simplify(distTol)
+
+	n := len(simp1) - 1
+	c.Curve.initSideSegments(simp1[0], simp1[1], side)
+	c.Curve.Add(c.Curve.offset1.P0)
+	for i := 2; i <= n; i++ {
+		c.Curve.addNextSegment(simp1[i], true)
+	}
+	c.Curve.Add(c.Curve.offset1.P1)
+}
+
+// offsetSections returns the sections of the raw offset curve which are on the boundary of the buffer,
+// the buffer curves are oriented with the interior of buffer on their right.
+// The raw curve is split at the intersections with itself and the buffer curves,
+// a part of it is on the boundary if the point just beside it on the side is exterior to the buffer.
+func offsetSections(raw matrix.LineMatrix, outline []matrix.LineMatrix, side int, distance float64) []matrix.LineMatrix {
+	if len(raw) < 2 {
+		return nil
+	}
+	splitter := &offsetSplitter{splits: make([][]matrix.Matrix, len(raw)-1)}
+	intersector := &chain.SegmentMutualIntersector{SegmentMutual: raw}
+	splitter.self = true
+	intersector.Process(raw, splitter)
+	splitter.self = false
+	for _, v := range outline {
+		intersector.Process(v, splitter)
+	}
+
+	locator := newWindingLocator(outline)
+	offset := distance * calc.DefaultTolerance6
+	if side == calc.SideRight {
+		offset = -offset
+	}
+
+	sections := []matrix.LineMatrix{}
+	var current matrix.LineMatrix
+	currentSeg := -1
+	for i := 0; i < len(raw)-1; i++ {
+		pts := splitter.segmentPoints(raw, i)
+		for j := 0; j < len(pts)-1; j++ {
+			p, r := pts[j], pts[j+1]
+			length := math.Hypot(r[0]-p[0], r[1]-p[1])
+			if length == 0 {
+				continue
+			}
+			// the point beside the middle of part on the side, the left normal is (-dy, dx).
+			beside := matrix.Matrix{
+				(p[0]+r[0])/2 - offset*(r[1]-p[1])/length,
+				(p[1]+r[1])/2 + offset*(r[0]-p[0])/length,
+			}
+			if locator.isInterior(beside) {
+				if current != nil {
+					sections = append(sections, current)
+					current = nil
+				}
+				continue
+			}
+			switch {
+			case current != nil && currentSeg == i && matrix.Matrix(current[len(current)-1]).Equals(p):
+				current[len(current)-1] = r
+			case current != nil && matrix.Matrix(current[len(current)-1]).Equals(p):
+				current = append(current, r)
+			default:
+				if current != nil {
+					sections = append(sections, current)
+				}
+				current = matrix.LineMatrix{p, r}
+			}
+			currentSeg = i
+		}
+	}
+	if current != nil {
+		sections = append(sections, current)
+	}
+	return sections
+}
+
+// joinSections joins the sections which one starts where the other ends within tolerance,
+// the sections are in the order along the raw curve and the last one may join the first one of a closed curve.
+func joinSections(sections []matrix.LineMatrix, isClosed bool, tolerance float64) []matrix.LineMatrix {
+	if len(sections) < 2 {
+		return sections
+	}
+	joined := []matrix.LineMatrix{sections[0]}
+	for _, v := range sections[1:] {
+		last := joined[len(joined)-1]
+		if matrix.Matrix(last[len(last)-1]).EqualsExact(matrix.Matrix(v[0]), tolerance) {
+			joined[len(joined)-1] = append(last, v[1:]...)
+			continue
+		}
+		joined = append(joined, v)
+	}
+	if n := len(joined); isClosed && n > 1 {
+		last := joined[n-1]
+		if matrix.Matrix(last[len(last)-1]).EqualsExact(matrix.Matrix(joined[0][0]), tolerance) {
+			joined[0] = append(last, joined[0][1:]...)
+			joined = joined[:n-1]
+		}
+	}
+	return joined
+}
+
+// offsetSplitter collects the points splitting the segments of the raw offset curve,
+// which is the first line of the intersected segments.
+type offsetSplitter struct {
+	splits [][]matrix.Matrix
+	self   bool
+}
+
+// ProcessIntersections adds the intersections of the segments to the split points of raw curve segment.
+func (o *offsetSplitter) ProcessIntersections(
+	e0 matrix.LineMatrix, segIndex0 int,
+	e1 matrix.LineMatrix, segIndex1 int) {
+	// don't bother intersecting a segment with itself
+	if o.self && segIndex0 == segIndex1 {
+		return
+	}
+	if mark, ips := operation.FindIntersection(e0[segIndex0], e0[segIndex0+1], e1[segIndex1], e1[segIndex1+1]); mark {
+		for _, v := range ips {
+			o.splits[segIndex0] = append(o.splits[segIndex0], v.Matrix)
+		}
+	}
+}
+
+// IsDone Always process all intersections
+func (o *offsetSplitter) IsDone() bool {
+	return false
+}
+
+// Result returns the split points.
+func (o *offsetSplitter) Result() interface{} {
+	return o.splits
+}
+
+// segmentPoints returns the points of segment index of line and its split points in the order along it.
+func (o *offsetSplitter) segmentPoints(line matrix.LineMatrix, index int) []matrix.Matrix {
+	a, b := matrix.Matrix(line[index]), matrix.Matrix(line[index+1])
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	along := func(p matrix.Matrix) float64 {
+		return (p[0]-a[0])*dx + (p[1]-a[1])*dy
+	}
+	length := along(b)
+	pts := []matrix.Matrix{a}
+	splits := o.splits[index]
+	sort.SliceStable(splits, func(i, j int) bool {
+		return along(splits[i]) < along(splits[j])
+	})
+	for _, v := range splits {
+		if t := along(v); t <= 0 || t >= length || pts[len(pts)-1].Equals(v) {
+			continue
+		}
+		pts = append(pts, v)
+	}
+	return append(pts, b)
+}
+
+// windingLocator Determines whether the points are in the interior of the buffer by the winding number of
+// the buffer curves around them, the segments of curves are indexed by their y intervals.
+type windingLocator struct {
+	index *intervalrtree.SortedPackedIntervalRTree
+}
+
+// newWindingLocator returns the locator of the buffer curves.
+func newWindingLocator(curves []matrix.LineMatrix) *windingLocator {
+	l := &windingLocator{index: &intervalrtree.SortedPackedIntervalRTree{}}
+	for _, curve := range curves {
+		for i := 0; i < len(curve)-1; i++ {
+			seg := &matrix.LineSegment{P0: curve[i], P1: curve[i+1]}
+			_ = l.index.Insert(envelope.FourFloat(seg.P0[1], seg.P1[1], 0, 0), seg)
+		}
+	}
+	return l
+}
+
+// isInterior returns true if point is in the interior of buffer,
+// where the curves oriented with the interior on the right wind clockwise around it.
+func (l *windingLocator) isInterior(p matrix.Matrix) bool {
+	counter := &windingCounter{p: p}
+	if err := l.index.QueryVisitor(envelope.FourFloat(p[1], p[1], 0, 0), counter); err != nil {
+		return false
+	}
+	return counter.winding < 0
+}
+
+// windingCounter computes the winding number of the segments around point,
+// the segment crossing the horizontal ray to the right of point upward counts one, downward minus one.
+type windingCounter struct {
+	p       matrix.Matrix
+	winding int
+}
+
+// VisitItem counts the crossing of segment.
+func (w *windingCounter) VisitItem(item interface{}) {
+	seg := item.(*matrix.LineSegment)
+	p, p0, p1 := w.p, seg.P0, seg.P1
+	switch {
+	case p0[1] <= p[1] && p1[1] > p[1]:
+		if calc.OrientationIndex(p0[0], p0[1], p1[0], p1[1], p[0], p[1]) == calc.CounterClockWise {
+			w.winding++
+		}
+	case p0[1] > p[1] && p1[1] <= p[1]:
+		if calc.OrientationIndex(p0[0], p0[1], p1[0], p1[1], p[0], p[1]) == calc.ClockWise {
+			w.winding--
+		}
+	}
+}
+
+// Items returns the winding number.
+func (w *windingCounter) Items() interface{} {
+	return w.winding
+}
+
+// removeRepeatedPoints returns a copy of the line without the repeated consecutive points.
+func removeRepeatedPoints(line matrix.LineMatrix) matrix.LineMatrix {
+	pts := make(matrix.LineMatrix, 0, len(line))
+	for _, v := range line {
+		if len(pts) > 0 && matrix.Matrix(pts[len(pts)-1]).Equals(matrix.Matrix(v)) {
+			continue
+		}
+		pts = append(pts, v)
+	}
+	return pts
+}
@@ -0,0 +1,96 @@
+package buffer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestOffsetCurve(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	step := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 1}, {20, 1}}
+	uTurn := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}}
+	square := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	mitre := &CurveParameters{8, calc.CapFlat, calc.JoinMitre, 5, 0.01, false}
+	bevel := &CurveParameters{8, calc.CapFlat, calc.JoinBevel, 5, 0.01, false}
+	type args struct {
+		line     matrix.LineMatrix
+		distance float64
+		param    *CurveParameters
+	}
+	tests := []struct {
+		name string
+		args args
+		want matrix.Steric
+	}{
+		{name: "left inside turn", args: args{line: line, distance: 1, param: mitre},
+			want: matrix.LineMatrix{{0, 1}, {9, 1}, {9, 10}}},
+		{name: "right mitre join", args: args{line: line, distance: -1, param: mitre},
+			want: matrix.LineMatrix{{0, -1}, {11, -1}, {11, 10}}},
+		{name: "right bevel join", args: args{line: line, distance: -1, param: bevel},
+			want: matrix.LineMatrix{{0, -1}, {10, -1}, {11, 0}, {11, 10}}},
+		{name: "repeated points", args: args{line: matrix.LineMatrix{{0, 0}, {0, 0}, {10, 0}}, distance: 2, param: nil},
+			want: matrix.LineMatrix{{0, 2}, {10, 2}}},
+		{name: "closed line left", args: args{line: square, distance: 1, param: mitre},
+			want: matrix.LineMatrix{{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}},
+		{name: "closed line right", args: args{line: square, distance: -1, param: mitre},
+			want: matrix.LineMatrix{{-1, -1}, {11, -1}, {11, 11}, {-1, 11}, {-1, -1}}},
+		{name: "closed line eroded", args: args{line: square, distance: 6, param: mitre},
+			want: matrix.LineMatrix{}},
+		{name: "sharp inside turn", args: args{line: step, distance: 3, param: mitre},
+			want: matrix.LineMatrix{{0, 3}, {7, 3}, {7, 4}, {20, 4}}},
+		{name: "u-turn inside", args: args{line: uTurn, distance: 2, param: nil},
+			want: matrix.LineMatrix{{0, 2}, {8, 2}, {8, 8}, {0, 8}}},
+		{name: "u-turn inside wider than turn", args: args{line: uTurn, distance: 6, param: nil},
+			want: matrix.LineMatrix{}},
+		{name: "u-turn outside", args: args{line: uTurn, distance: -2, param: mitre},
+			want: matrix.LineMatrix{{0, -2}, {12, -2}, {12, 12}, {0, 12}}},
+		{name: "hairpin inside", args: args{line: matrix.LineMatrix{{0, 0}, {10, 0}, {0, 1}}, distance: 3, param: nil},
+			want: matrix.LineMatrix{}},
+		{name: "line folded into sections", args: args{line: matrix.LineMatrix{{0, 0}, {10, 0}, {10, 4}, {5, 4}, {5, -4}}, distance: 1, param: mitre},
+			want: matrix.Collection{matrix.LineMatrix{{0, 1}, {4, 1}}, matrix.LineMatrix{{6, 1}, {9, 1}, {9, 3}, {6, 3}, {6, 1}},
+				matrix.LineMatrix{{6, -1}, {6, -4}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OffsetCurve(tt.args.line, tt.args.distance, tt.args.param); !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("OffsetCurve() = %v,\n want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOffsetCurve_Round(t *testing.T) {
+	tests := []struct {
+		name       string
+		line       matrix.LineMatrix
+		distance   float64
+		start, end matrix.Matrix
+	}{
+		{name: "sharp inside turn", line: matrix.LineMatrix{{0, 0}, {10, 0}, {10, 1}, {20, 1}}, distance: 3,
+			start: matrix.Matrix{0, 3}, end: matrix.Matrix{20, 4}},
+		{name: "hairpin outside", line: matrix.LineMatrix{{0, 0}, {10, 0}, {0, 1}}, distance: -3,
+			start: matrix.Matrix{0, -3}, end: matrix.Matrix{0.29851115706299675, 3.9851115706299676}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := OffsetCurve(tt.line, tt.distance, nil).(matrix.LineMatrix)
+			if !ok {
+				t.Fatalf("OffsetCurve() = %v, want a line", got)
+			}
+			if !matrix.Matrix(got[0]).EqualsExact(tt.start, 0.000001) || !matrix.Matrix(got[len(got)-1]).EqualsExact(tt.end, 0.000001) {
+				t.Errorf("OffsetCurve() = %v, want from %v to %v", got, tt.start, tt.end)
+			}
+			// the vertices are on the buffer boundary, the ones on the chords of fillets are a little closer.
+			minDistance := math.Abs(tt.distance) * math.Cos(math.Pi/4/calc.QuadrantSegments)
+			for _, v := range got {
+				if d := measure.PlanarDistance(matrix.Matrix(v), tt.line); d < minDistance-0.000001 {
+					t.Errorf("OffsetCurve() vertex %v is at %v from the line, want at least %v", v, d, minDistance)
+				}
+			}
+		})
+	}
+}
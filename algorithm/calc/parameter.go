@@ -69,6 +69,7 @@ const (
 	// ClockWise ...
 	ClockWise        = -1
 	CounterClockWise = 1
+	Collinear        = 0
 
 	SideLeft  = 1
 	SideRight = 2
@@ -0,0 +1,133 @@
+package calc
+
+import (
+	"math"
+	"math/big"
+)
+
+// the error bounds of the filters of predicates, see Shewchuk, Adaptive Precision Floating-Point Arithmetic
+// and Fast Robust Geometric Predicates.
+var (
+	epsilon      = math.Ldexp(1, -53)
+	ccwErrBoundA = (3 + 16*epsilon) * epsilon
+	iccErrBoundA = (10 + 96*epsilon) * epsilon
+)
+
+// OrientationIndex Returns the orientation index of point (cx, cy) relative to the directed line from (ax, ay) to (bx, by),
+// CounterClockWise if it is on the left, ClockWise if on the right, Collinear if on the line.
+// The determinant is computed in float64 if its error bound proves the sign, otherwise in exact arithmetic,
+// so the result is always correct and consistent.
+func OrientationIndex(ax, ay, bx, by, cx, cy float64) int {
+	detLeft := (ax - cx) * (by - cy)
+	detRight := (ay - cy) * (bx - cx)
+	det := detLeft - detRight
+	detSum := 0.0
+	switch {
+	case detLeft > 0:
+		if detRight <= 0 {
+			return Signum(det)
+		}
+		detSum = detLeft + detRight
+	case detLeft < 0:
+		if detRight >= 0 {
+			return Signum(det)
+		}
+		detSum = -detLeft - detRight
+	default:
+		return Signum(det)
+	}
+	if errBound := ccwErrBoundA * detSum; det >= errBound || -det >= errBound {
+		return Signum(det)
+	}
+	return exactCrossProductSign(ax, ay, bx, by, ax, ay, cx, cy)
+}
+
+// CrossProductSign Returns the sign of the cross product of vectors (ax0, ay0)-(ax1, ay1) and (bx0, by0)-(bx1, by1),
+// which is 1 if the second vector turns counter clockwise from the first one, -1 if clockwise and 0 if they are parallel.
+// It is exact as OrientationIndex.
+func CrossProductSign(ax0, ay0, ax1, ay1, bx0, by0, bx1, by1 float64) int {
+	detLeft := (ax1 - ax0) * (by1 - by0)
+	detRight := (ay1 - ay0) * (bx1 - bx0)
+	det := detLeft - detRight
+	if errBound := ccwErrBoundA * (math.Abs(detLeft) + math.Abs(detRight)); det > errBound || -det > errBound {
+		return Signum(det)
+	}
+	return exactCrossProductSign(ax0, ay0, ax1, ay1, bx0, by0, bx1, by1)
+}
+
+// InCircle Returns 1 if point (px, py) is inside the circle through the points a, b and c in counter clockwise,
+// -1 if it is outside, 0 if it is on the circle. The sign is reversed if a, b and c are in clockwise.
+// The determinant is computed in float64 if its error bound proves the sign, otherwise in exact arithmetic.
+func InCircle(ax, ay, bx, by, cx, cy, px, py float64) int {
+	adx, ady := ax-px, ay-py
+	bdx, bdy := bx-px, by-py
+	cdx, cdy := cx-px, cy-py
+
+	bdxcdy, cdxbdy := bdx*cdy, cdx*bdy
+	cdxady, adxcdy := cdx*ady, adx*cdy
+	adxbdy, bdxady := adx*bdy, bdx*ady
+	alift := adx*adx + ady*ady
+	blift := bdx*bdx + bdy*bdy
+	clift := cdx*cdx + cdy*cdy
+
+	det := alift*(bdxcdy-cdxbdy) + blift*(cdxady-adxcdy) + clift*(adxbdy-bdxady)
+	permanent := (math.Abs(bdxcdy)+math.Abs(cdxbdy))*alift +
+		(math.Abs(cdxady)+math.Abs(adxcdy))*blift +
+		(math.Abs(adxbdy)+math.Abs(bdxady))*clift
+	if errBound := iccErrBoundA * permanent; det > errBound || -det > errBound {
+		return Signum(det)
+	}
+	return exactInCircle(ax, ay, bx, by, cx, cy, px, py)
+}
+
+// exactCrossProductSign returns the sign of the cross product of vectors in exact rational arithmetic.
+func exactCrossProductSign(ax0, ay0, ax1, ay1, bx0, by0, bx1, by1 float64) int {
+	if !isFinite(ax0, ay0, ax1, ay1, bx0, by0, bx1, by1) {
+		return 0
+	}
+	adx, ady := exactSub(ax1, ax0), exactSub(ay1, ay0)
+	bdx, bdy := exactSub(bx1, bx0), exactSub(by1, by0)
+	left := new(big.Rat).Mul(adx, bdy)
+	right := new(big.Rat).Mul(ady, bdx)
+	return left.Sub(left, right).Sign()
+}
+
+// exactInCircle returns the sign of the in circle determinant in exact rational arithmetic.
+func exactInCircle(ax, ay, bx, by, cx, cy, px, py float64) int {
+	if !isFinite(ax, ay, bx, by, cx, cy, px, py) {
+		return 0
+	}
+	adx, ady := exactSub(ax, px), exactSub(ay, py)
+	bdx, bdy := exactSub(bx, px), exactSub(by, py)
+	cdx, cdy := exactSub(cx, px), exactSub(cy, py)
+
+	lift := func(dx, dy *big.Rat) *big.Rat {
+		l := new(big.Rat).Mul(dx, dx)
+		return l.Add(l, new(big.Rat).Mul(dy, dy))
+	}
+	cross := func(x1, y1, x2, y2 *big.Rat) *big.Rat {
+		c := new(big.Rat).Mul(x1, y2)
+		return c.Sub(c, new(big.Rat).Mul(y1, x2))
+	}
+
+	det := new(big.Rat).Mul(lift(adx, ady), cross(bdx, bdy, cdx, cdy))
+	det.Add(det, new(big.Rat).Mul(lift(bdx, bdy), cross(cdx, cdy, adx, ady)))
+	det.Add(det, new(big.Rat).Mul(lift(cdx, cdy), cross(adx, ady, bdx, bdy)))
+	return det.Sign()
+}
+
+// exactSub returns x - y in exact rational arithmetic.
+func exactSub(x, y float64) *big.Rat {
+	r := new(big.Rat).SetFloat64(x)
+	return r.Sub(r, new(big.Rat).SetFloat64(y))
+}
+
+// isFinite returns true if none of values is NaN or infinity.
+func isFinite(values ...float64) bool {
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+package calc
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// orientationByRat returns the orientation index in the rational arithmetic.
+func orientationByRat(ax, ay, bx, by, cx, cy float64) int {
+	rat := func(x float64) *big.Rat { return new(big.Rat).SetFloat64(x) }
+	left := new(big.Rat).Mul(new(big.Rat).Sub(rat(bx), rat(ax)), new(big.Rat).Sub(rat(cy), rat(ay)))
+	right := new(big.Rat).Mul(new(big.Rat).Sub(rat(by), rat(ay)), new(big.Rat).Sub(rat(cx), rat(ax)))
+	return left.Sub(left, right).Sign()
+}
+
+func TestOrientationIndex(t *testing.T) {
+	tests := []struct {
+		name                   string
+		ax, ay, bx, by, cx, cy float64
+		want                   int
+	}{
+		{"left", 0, 0, 1, 0, 0, 1, CounterClockWise},
+		{"right", 0, 0, 1, 0, 0, -1, ClockWise},
+		{"collinear", 0, 0, 1, 1, 2, 2, Collinear},
+		{"collinear far", 12, 12, 24, 24, 0.5, 0.5, Collinear},
+		{"near collinear", 12, 12, 24, 24, math.Nextafter(0.5, 1), 0.5, ClockWise},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OrientationIndex(tt.ax, tt.ay, tt.bx, tt.by, tt.cx, tt.cy); got != tt.want {
+				t.Errorf("OrientationIndex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrientationIndex_NearCollinear(t *testing.T) {
+	// the points around (0.5, 0.5) in the steps of ulp, on which the float64 orientation is inconsistent.
+	ulp := math.Nextafter(0.5, 1) - 0.5
+	for i := 0; i < 64; i++ {
+		for j := 0; j < 64; j++ {
+			x, y := 0.5+float64(i)*ulp, 0.5+float64(j)*ulp
+			want := orientationByRat(12, 12, 24, 24, x, y)
+			if got := OrientationIndex(12, 12, 24, 24, x, y); got != want {
+				t.Fatalf("OrientationIndex() of (%v, %v) = %v, want %v", i, j, got, want)
+			}
+			if got := OrientationIndex(24, 24, x, y, 12, 12); got != want {
+				t.Fatalf("OrientationIndex() rotated of (%v, %v) = %v, want %v", i, j, got, want)
+			}
+			if got := OrientationIndex(24, 24, 12, 12, x, y); got != -want {
+				t.Fatalf("OrientationIndex() reversed of (%v, %v) = %v, want %v", i, j, got, -want)
+			}
+		}
+	}
+}
+
+func TestCrossProductSign(t *testing.T) {
+	tests := []struct {
+		name string
+		v    [8]float64
+		want int
+	}{
+		{"counter clockwise", [8]float64{0, 0, 1, 0, 5, 5, 5, 6}, CounterClockWise},
+		{"clockwise", [8]float64{0, 0, 0, 1, 5, 5, 6, 5}, ClockWise},
+		{"parallel", [8]float64{0, 0, 1, 1, 5, 3, 7, 5}, Collinear},
+		{"near parallel", [8]float64{0, 0, 12, 12, 0.5, 0.5, math.Nextafter(24, 25), 24}, ClockWise},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := tt.v
+			if got := CrossProductSign(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7]); got != tt.want {
+				t.Errorf("CrossProductSign() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInCircle(t *testing.T) {
+	tests := []struct {
+		name                           string
+		ax, ay, bx, by, cx, cy, px, py float64
+		want                           int
+	}{
+		{"inside", 0, 0, 1, 0, 1, 1, 0.5, 0.5, 1},
+		{"outside", 0, 0, 1, 0, 1, 1, 2, 2, -1},
+		{"on circle", 0, 0, 1, 0, 1, 1, 0, 1, 0},
+		{"clockwise", 0, 0, 1, 1, 1, 0, 0.5, 0.5, -1},
+		{"on circle far", 1e6, 1e6, 1e6 + 0.1, 1e6, 1e6 + 0.1, 1e6 + 0.1, 1e6, 1e6 + 0.1, 0},
+		{"near circle", 0, 0, 1, 0, 1, 1, math.Nextafter(0, 1), 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InCircle(tt.ax, tt.ay, tt.bx, tt.by, tt.cx, tt.cy, tt.px, tt.py); got != tt.want {
+				t.Errorf("InCircle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Package densify inserts the points into the segments of geometry, so no segment is longer than the given length.
+// The segments are divided in the plane or along the great circle.
+package densify
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// Densify returns the geometry with the points inserted into the segments longer than maxSegmentLength,
+// the points divide the segment into the equal parts. The length is in the planar units.
+// The ordinates other than x and y, e.g. the measure, are interpolated linearly.
+// The geometry is returned unchanged if maxSegmentLength is not positive.
+func Densify(m matrix.Steric, maxSegmentLength float64) matrix.Steric {
+	return densifyGeometry(m, maxSegmentLength, planarSegment)
+}
+
+// SegmentizeInMeter returns the geometry of longitude and latitude in degrees with the great circle points
+// inserted into the segments longer than maxSegmentLength in meter, the points divide the segment
+// into the equal parts. The sphere of measure.R is used, the longitudes are kept continuous
+// so the segment crossing the antimeridian may have the longitudes beyond 180.
+// The ordinates other than longitude and latitude are interpolated linearly.
+// The geometry is returned unchanged if maxSegmentLength is not positive.
+func SegmentizeInMeter(m matrix.Steric, maxSegmentLength float64) matrix.Steric {
+	return densifyGeometry(m, maxSegmentLength, geodesicSegment)
+}
+
+// segmentFunc returns the length of segment ab and the function of the point at the fraction of it.
+type segmentFunc func(a, b matrix.Matrix) (float64, func(f float64) matrix.Matrix)
+
+// densifyGeometry densifies all the lines and rings of geometry.
+func densifyGeometry(m matrix.Steric, maxSegmentLength float64, segment segmentFunc) matrix.Steric {
+	if maxSegmentLength <= 0 {
+		return m
+	}
+	switch mm := m.(type) {
+	case matrix.LineMatrix:
+		return densifyLine(mm, maxSegmentLength, segment)
+	case matrix.PolygonMatrix:
+		poly := make(matrix.PolygonMatrix, 0, len(mm))
+		for _, ring := range mm {
+			poly = append(poly, densifyLine(ring, maxSegmentLength, segment))
+		}
+		return poly
+	case matrix.MultiPolygonMatrix:
+		multi := make(matrix.MultiPolygonMatrix, 0, len(mm))
+		for _, poly := range mm {
+			multi = append(multi, densifyGeometry(matrix.PolygonMatrix(poly), maxSegmentLength, segment).(matrix.PolygonMatrix))
+		}
+		return multi
+	case matrix.Collection:
+		coll := make(matrix.Collection, 0, len(mm))
+		for _, v := range mm {
+			coll = append(coll, densifyGeometry(v, maxSegmentLength, segment))
+		}
+		return coll
+	}
+	return m
+}
+
+// densifyLine inserts the points into the segments of line.
+func densifyLine(line matrix.LineMatrix, maxSegmentLength float64, segment segmentFunc) matrix.LineMatrix {
+	if len(line) < 2 {
+		return line
+	}
+	result := matrix.LineMatrix{line[0]}
+	for i := 0; i < len(line)-1; i++ {
+		length, pointAt := segment(line[i], line[i+1])
+		n := int(math.Ceil(length / maxSegmentLength))
+		for j := 1; j < n; j++ {
+			result = append(result, pointAt(float64(j)/float64(n)))
+		}
+		result = append(result, line[i+1])
+	}
+	return result
+}
+
+// planarSegment returns the length of the planar segment and its points.
+func planarSegment(a, b matrix.Matrix) (float64, func(f float64) matrix.Matrix) {
+	return math.Hypot(b[0]-a[0], b[1]-a[1]), func(f float64) matrix.Matrix {
+		return interpolate(a, b, f, a[0]+f*(b[0]-a[0]), a[1]+f*(b[1]-a[1]))
+	}
+}
+
+// geodesicSegment returns the length in meter of the great circle segment and its points.
+func geodesicSegment(a, b matrix.Matrix) (float64, func(f float64) matrix.Matrix) {
+	rad := math.Pi / 180
+	lat0, lng0, lat1, lng1 := a[1]*rad, a[0]*rad, b[1]*rad, b[0]*rad
+	// the haversine formula is stable for the short segments.
+	h := math.Pow(math.Sin((lat1-lat0)/2), 2) + math.Cos(lat0)*math.Cos(lat1)*math.Pow(math.Sin((lng1-lng0)/2), 2)
+	delta := 2 * math.Asin(math.Sqrt(math.Min(1, h)))
+	if math.Sin(delta) == 0 {
+		// the segment has no length or its great circle is not unique.
+		return 0, nil
+	}
+	return delta * measure.R, func(f float64) matrix.Matrix {
+		ka, kb := math.Sin((1-f)*delta)/math.Sin(delta), math.Sin(f*delta)/math.Sin(delta)
+		x := ka*math.Cos(lat0)*math.Cos(lng0) + kb*math.Cos(lat1)*math.Cos(lng1)
+		y := ka*math.Cos(lat0)*math.Sin(lng0) + kb*math.Cos(lat1)*math.Sin(lng1)
+		z := ka*math.Sin(lat0) + kb*math.Sin(lat1)
+		lat, lng := math.Atan2(z, math.Hypot(x, y))/rad, math.Atan2(y, x)/rad
+		// the longitude is the one nearest to the linear interpolation, which keeps it continuous.
+		linear := a[0] + f*(b[0]-a[0])
+		lng += 360 * math.Round((linear-lng)/360)
+		return interpolate(a, b, f, lng, lat)
+	}
+}
+
+// interpolate returns the point of x and y with the other ordinates interpolated linearly between a and b.
+func interpolate(a, b matrix.Matrix, f, x, y float64) matrix.Matrix {
+	p := matrix.Matrix{x, y}
+	for i := 2; i < len(a) && i < len(b); i++ {
+		p = append(p, a[i]+f*(b[i]-a[i]))
+	}
+	return p
+}
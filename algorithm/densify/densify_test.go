@@ -0,0 +1,67 @@
+package densify
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestDensify(t *testing.T) {
+	tests := []struct {
+		name             string
+		m                matrix.Steric
+		maxSegmentLength float64
+		want             matrix.Steric
+	}{
+		{"point", matrix.Matrix{1, 1}, 1, matrix.Matrix{1, 1}},
+		{"line", matrix.LineMatrix{{0, 0}, {12, 0}, {12, 1}}, 3.5,
+			matrix.LineMatrix{{0, 0}, {3, 0}, {6, 0}, {9, 0}, {12, 0}, {12, 1}}},
+		{"measure", matrix.LineMatrix{{0, 0, 0}, {4, 0, 8}}, 2,
+			matrix.LineMatrix{{0, 0, 0}, {2, 0, 4}, {4, 0, 8}}},
+		{"polygon", matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 0}}}, 1.5,
+			matrix.PolygonMatrix{{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}, {1, 1}, {0, 0}}}},
+		{"collection", matrix.Collection{matrix.Matrix{1, 1}, matrix.LineMatrix{{0, 0}, {0, 2}}}, 1,
+			matrix.Collection{matrix.Matrix{1, 1}, matrix.LineMatrix{{0, 0}, {0, 1}, {0, 2}}}},
+		{"not positive", matrix.LineMatrix{{0, 0}, {10, 0}}, 0, matrix.LineMatrix{{0, 0}, {10, 0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Densify(tt.m, tt.maxSegmentLength); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Densify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegmentizeInMeter(t *testing.T) {
+	// the great circle from Beijing to New York passes near the north pole.
+	line := matrix.LineMatrix{{116.4, 39.9}, {-74, 40.7}}
+	got := SegmentizeInMeter(line, 1000000).(matrix.LineMatrix)
+	if len(got) != 12 || !matrix.Matrix(got[0]).Equals(matrix.Matrix(line[0])) ||
+		!matrix.Matrix(got[len(got)-1]).Equals(matrix.Matrix(line[1])) {
+		t.Fatalf("SegmentizeInMeter() = %v", got)
+	}
+	maxLat, total := 0.0, 0.0
+	for i := 0; i < len(got)-1; i++ {
+		maxLat = math.Max(maxLat, got[i][1])
+		total += measure.SpheroidDistance(matrix.Matrix(got[i]), matrix.Matrix(got[i+1]))
+	}
+	if maxLat < 80 {
+		t.Errorf("SegmentizeInMeter() max latitude = %v, want larger than 80", maxLat)
+	}
+	if want := measure.SpheroidDistance(matrix.Matrix(line[0]), matrix.Matrix(line[1])); math.Abs(total-want) > 1 {
+		t.Errorf("SegmentizeInMeter() length = %v, want %v", total, want)
+	}
+
+	// the longitudes are continuous across the antimeridian.
+	got = SegmentizeInMeter(matrix.LineMatrix{{179, 0}, {181, 0}}, 100000).(matrix.LineMatrix)
+	for i := 0; i < len(got)-1; i++ {
+		if got[i+1][0] <= got[i][0] || math.Abs(got[i][1]) > 1e-9 {
+			t.Errorf("SegmentizeInMeter() = %v", got)
+			break
+		}
+	}
+}
@@ -0,0 +1,250 @@
+// Package georeference fits the transformations from the ground control points by least squares,
+// which georeference the scanned plans and the drawings to the world coordinates.
+package georeference
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// const the methods of transformation.
+const (
+	// Helmert is the 2D similarity transformation of translation, rotation and uniform scaling,
+	// it needs 2 control points at least.
+	Helmert = iota
+
+	// Affine is the 2D affine transformation, it needs 3 control points at least.
+	Affine
+
+	// Polynomial2 is the polynomial transformation of 2nd order, it needs 6 control points at least.
+	Polynomial2
+
+	// Polynomial3 is the polynomial transformation of 3rd order, it needs 10 control points at least.
+	Polynomial3
+)
+
+// Transformation is the transformation fitted from the control points.
+// The source coordinates are normalized about their centre before the polynomial is evaluated,
+// which keeps the equations of high order stable.
+type Transformation struct {
+	method       int
+	origin       matrix.Matrix
+	scale        float64
+	coefficients [2][]float64
+}
+
+// FitResult is the result of fitting the transformation.
+type FitResult struct {
+	Transformation *Transformation
+	// Residuals are the differences of the transformed source points from the target points.
+	Residuals []matrix.Matrix
+	// RMSE is the root mean square error of the residuals.
+	RMSE float64
+}
+
+// Fit fits the transformation of the method from the source control points to the target control points
+// by least squares, the normal equations are solved in the double-double arithmetic.
+// Returns error if the points are not paired or not enough for the method, or they are degenerate, e.g. collinear.
+func Fit(source, target []matrix.Matrix, method int) (*FitResult, error) {
+	if len(source) != len(target) || method < Helmert || method > Polynomial3 || len(source) < minimumPoints(method) {
+		return nil, algorithm.ErrWrongControlPoints
+	}
+	t := &Transformation{method: method, origin: matrix.Matrix{0, 0}, scale: 1}
+	for _, p := range source {
+		t.origin[0] += p[0] / float64(len(source))
+		t.origin[1] += p[1] / float64(len(source))
+	}
+	scale := 0.0
+	for _, p := range source {
+		scale = math.Max(scale, math.Max(math.Abs(p[0]-t.origin[0]), math.Abs(p[1]-t.origin[1])))
+	}
+	if scale > 0 {
+		t.scale = scale
+	}
+
+	if method == Helmert {
+		// x' = a*u - b*v + c, y' = b*u + a*v + d of the normalized u and v.
+		rows, values := [][]float64{}, []float64{}
+		for i, p := range source {
+			u, v := t.normalize(p)
+			rows = append(rows, []float64{u, -v, 1, 0}, []float64{v, u, 0, 1})
+			values = append(values, target[i][0], target[i][1])
+		}
+		solution, err := leastSquares(rows, values)
+		if err != nil {
+			return nil, err
+		}
+		a, b, c, d := solution[0], solution[1], solution[2], solution[3]
+		t.coefficients = [2][]float64{{c, a, -b}, {d, b, a}}
+	} else {
+		rows := [][]float64{}
+		for _, p := range source {
+			u, v := t.normalize(p)
+			rows = append(rows, polynomialTerms(t.order(), u, v))
+		}
+		for axis := 0; axis < 2; axis++ {
+			values := make([]float64, 0, len(target))
+			for _, p := range target {
+				values = append(values, p[axis])
+			}
+			solution, err := leastSquares(rows, values)
+			if err != nil {
+				return nil, err
+			}
+			t.coefficients[axis] = solution
+		}
+	}
+
+	result := &FitResult{Transformation: t}
+	sum := 0.0
+	for i, p := range source {
+		q := t.TransformMatrix(p)
+		residual := matrix.Matrix{q[0] - target[i][0], q[1] - target[i][1]}
+		result.Residuals = append(result.Residuals, residual)
+		sum += residual[0]*residual[0] + residual[1]*residual[1]
+	}
+	result.RMSE = math.Sqrt(sum / float64(len(source)))
+	return result, nil
+}
+
+// Method returns the method of transformation.
+func (t *Transformation) Method() int {
+	return t.method
+}
+
+// TransformMatrix returns the transformed point, the ordinates other than x and y are kept.
+func (t *Transformation) TransformMatrix(p matrix.Matrix) matrix.Matrix {
+	if len(p) < 2 {
+		return p
+	}
+	u, v := t.normalize(p)
+	terms := polynomialTerms(t.order(), u, v)
+	result := append(matrix.Matrix{}, p...)
+	for axis := 0; axis < 2; axis++ {
+		result[axis] = 0
+		for i, c := range t.coefficients[axis] {
+			result[axis] += c * terms[i]
+		}
+	}
+	return result
+}
+
+// Transform returns the transformed copy of the steric, the geometry is transformed by
+// space.TransGeometry(t.Transform(geom.ToMatrix())).
+func (t *Transformation) Transform(s matrix.Steric) matrix.Steric {
+	return matrix.TransformSteric(s, t.TransformMatrix)
+}
+
+// AffineTransformation returns the transformation as the affine transformation,
+// returns nil if it is the polynomial transformation.
+func (t *Transformation) AffineTransformation() *matrix.AffineTransformation {
+	if t.order() > 1 {
+		return nil
+	}
+	cx, cy := t.coefficients[0], t.coefficients[1]
+	return matrix.NewAffineTransformation().
+		Translate(-t.origin[0], -t.origin[1]).
+		Scale(1/t.scale, 1/t.scale).
+		Compose(matrix.NewAffineTransformation2D(cx[1], cx[2], cx[0], cy[1], cy[2], cy[0]))
+}
+
+// normalize returns the normalized coordinates of point.
+func (t *Transformation) normalize(p matrix.Matrix) (u, v float64) {
+	return (p[0] - t.origin[0]) / t.scale, (p[1] - t.origin[1]) / t.scale
+}
+
+// order returns the order of polynomial.
+func (t *Transformation) order() int {
+	switch t.method {
+	case Polynomial2:
+		return 2
+	case Polynomial3:
+		return 3
+	}
+	return 1
+}
+
+// minimumPoints returns the number of control points the method needs at least.
+func minimumPoints(method int) int {
+	switch method {
+	case Helmert:
+		return 2
+	case Affine:
+		return 3
+	case Polynomial2:
+		return 6
+	}
+	return 10
+}
+
+// polynomialTerms returns the terms 1, u, v, u^2, uv, v^2, u^3, ... up to the order.
+func polynomialTerms(order int, u, v float64) []float64 {
+	terms := []float64{}
+	for n := 0; n <= order; n++ {
+		for j := 0; j <= n; j++ {
+			terms = append(terms, math.Pow(u, float64(n-j))*math.Pow(v, float64(j)))
+		}
+	}
+	return terms
+}
+
+// leastSquares solves the normal equations of the rows and values by the Gaussian elimination
+// with partial pivoting, returns error if the equations are singular.
+func leastSquares(rows [][]float64, values []float64) ([]float64, error) {
+	n := len(rows[0])
+	normal := make([][]*calc.PairFloat, n)
+	rhs := make([]*calc.PairFloat, n)
+	for i := 0; i < n; i++ {
+		normal[i] = make([]*calc.PairFloat, n)
+		for j := 0; j < n; j++ {
+			normal[i][j] = calc.ValueOf(0)
+		}
+		rhs[i] = calc.ValueOf(0)
+	}
+	for k, row := range rows {
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				normal[i][j].SelfAddPair(calc.ValueOf(row[i]).SelfMultiply(row[j], 0))
+			}
+			rhs[i].SelfAddPair(calc.ValueOf(row[i]).SelfMultiply(values[k], 0))
+		}
+	}
+
+	maxDiagonal := 0.0
+	for i := 0; i < n; i++ {
+		maxDiagonal = math.Max(maxDiagonal, math.Abs(normal[i][i].Value()))
+	}
+	for i := 0; i < n; i++ {
+		pivot := i
+		for k := i + 1; k < n; k++ {
+			if math.Abs(normal[k][i].Value()) > math.Abs(normal[pivot][i].Value()) {
+				pivot = k
+			}
+		}
+		if math.Abs(normal[pivot][i].Value()) <= calc.DefaultTolerance*maxDiagonal {
+			return nil, algorithm.ErrDegenerateControlPoints
+		}
+		normal[i], normal[pivot] = normal[pivot], normal[i]
+		rhs[i], rhs[pivot] = rhs[pivot], rhs[i]
+		for k := i + 1; k < n; k++ {
+			factor := normal[k][i].DividePair(normal[i][i])
+			for j := i; j < n; j++ {
+				normal[k][j].SelfSubtractPair(factor.MultiplyPair(normal[i][j]))
+			}
+			rhs[k].SelfSubtractPair(factor.MultiplyPair(rhs[i]))
+		}
+	}
+
+	solution := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := rhs[i].AddPair(calc.ValueOf(0))
+		for j := i + 1; j < n; j++ {
+			sum.SelfSubtractPair(normal[i][j].Multiply(solution[j], 0))
+		}
+		solution[i] = sum.SelfDividePair(normal[i][i]).Value()
+	}
+	return solution, nil
+}
@@ -0,0 +1,94 @@
+package georeference
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+var controlPoints = []matrix.Matrix{
+	{0, 0}, {100, 0}, {100, 100}, {0, 100}, {50, 50}, {20, 80}, {70, 30}, {90, 60}, {30, 10}, {60, 90}, {10, 40},
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		name   string
+		method int
+		warp   func(p matrix.Matrix) matrix.Matrix
+	}{
+		{"helmert", Helmert, matrix.NewAffineTransformation().Scale(2, 2).Rotate(0.3).Translate(500000, 4000000).TransformMatrix},
+		{"affine", Affine, matrix.NewAffineTransformation().Shear(0.2, 0.1).Scale(3, 2).Translate(100, -50).TransformMatrix},
+		{"polynomial 2", Polynomial2, func(p matrix.Matrix) matrix.Matrix {
+			return matrix.Matrix{1000 + 2*p[0] + 0.01*p[0]*p[1], 2000 + p[1] - 0.002*p[0]*p[0]}
+		}},
+		{"polynomial 3", Polynomial3, func(p matrix.Matrix) matrix.Matrix {
+			return matrix.Matrix{p[0] + 1e-5*p[0]*p[0]*p[1], p[1] + 1e-4*p[1]*p[1] - 2e-6*p[0]*p[0]*p[0]}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := []matrix.Matrix{}
+			for _, p := range controlPoints {
+				target = append(target, tt.warp(p))
+			}
+			result, err := Fit(controlPoints, target, tt.method)
+			if err != nil {
+				t.Fatalf("Fit() error = %v", err)
+			}
+			if result.RMSE > 1e-6 || len(result.Residuals) != len(controlPoints) {
+				t.Errorf("Fit() RMSE = %v, residuals %v", result.RMSE, len(result.Residuals))
+			}
+			p := matrix.Matrix{37, 41, 5}
+			got, want := result.Transformation.Transform(p).(matrix.Matrix), tt.warp(p)
+			if !got[:2].EqualsExact(want[:2], 1e-6) || got[2] != 5 {
+				t.Errorf("Transform() = %v, want %v", got, want)
+			}
+			if affine := result.Transformation.AffineTransformation(); affine != nil &&
+				!affine.TransformMatrix(p)[:2].EqualsExact(want[:2], 1e-6) {
+				t.Errorf("AffineTransformation() = %v, want %v", affine.TransformMatrix(p), want)
+			}
+		})
+	}
+}
+
+func TestFit_Residuals(t *testing.T) {
+	source := []matrix.Matrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}}
+	target := []matrix.Matrix{{0, 0}, {10, 0}, {10, 10}, {0, 11}}
+	result, err := Fit(source, target, Affine)
+	if err != nil {
+		t.Fatalf("Fit() error = %v", err)
+	}
+	sum := 0.0
+	for _, r := range result.Residuals {
+		sum += r[0]*r[0] + r[1]*r[1]
+	}
+	// the error of the last point is spread over all the points.
+	if want := math.Sqrt(sum / 4); math.Abs(result.RMSE-want) > 1e-12 || math.Abs(result.RMSE-0.25) > 1e-9 {
+		t.Errorf("Fit() RMSE = %v, want %v", result.RMSE, 0.25)
+	}
+}
+
+func TestFit_Error(t *testing.T) {
+	tests := []struct {
+		name           string
+		source, target []matrix.Matrix
+		method         int
+		want           error
+	}{
+		{"not paired", []matrix.Matrix{{0, 0}, {1, 1}}, []matrix.Matrix{{0, 0}}, Helmert, algorithm.ErrWrongControlPoints},
+		{"not enough", []matrix.Matrix{{0, 0}, {1, 1}}, []matrix.Matrix{{0, 0}, {1, 1}}, Affine, algorithm.ErrWrongControlPoints},
+		{"collinear", []matrix.Matrix{{0, 0}, {1, 1}, {2, 2}}, []matrix.Matrix{{0, 0}, {1, 1}, {2, 2}}, Affine,
+			algorithm.ErrDegenerateControlPoints},
+		{"identical", []matrix.Matrix{{1, 1}, {1, 1}}, []matrix.Matrix{{0, 0}, {1, 1}}, Helmert,
+			algorithm.ErrDegenerateControlPoints},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Fit(tt.source, tt.target, tt.method); err != tt.want {
+				t.Errorf("Fit() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Package linearref locates the points and the parts along the lines by the length from the start,
+// the lines of a multi line are measured one after another.
+package linearref
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// Length returns the total length of lines.
+func Length(lines []matrix.LineMatrix) float64 {
+	length := 0.0
+	for _, line := range lines {
+		length += lineLength(line)
+	}
+	return length
+}
+
+// ExtractPoint returns the point at the length along the lines,
+// the negative length is measured from the end and the length beyond the lines is clamped to them.
+// Returns nil if the lines are empty.
+func ExtractPoint(lines []matrix.LineMatrix, index float64) matrix.Matrix {
+	index = clampIndex(lines, index)
+	var last matrix.Matrix
+	for _, line := range lines {
+		for i := 0; i < len(line)-1; i++ {
+			seg := &matrix.LineSegment{P0: line[i], P1: line[i+1]}
+			length := math.Hypot(seg.P1[0]-seg.P0[0], seg.P1[1]-seg.P0[1])
+			if index <= length && length > 0 {
+				return seg.PointAlong(index / length)
+			}
+			index -= length
+			last = seg.P1
+		}
+		if len(line) == 1 {
+			last = line[0]
+		}
+	}
+	return last
+}
+
+// ExtractLine returns the parts of lines between the two lengths,
+// the negative length is measured from the end and the length beyond the lines is clamped to them.
+// The parts are reversed if start is larger than end, the part is two identical points if they are equal.
+// Returns no part if the lines have no segment.
+func ExtractLine(lines []matrix.LineMatrix, start, end float64) []matrix.LineMatrix {
+	start, end = clampIndex(lines, start), clampIndex(lines, end)
+	if start > end {
+		parts := ExtractLine(lines, end, start)
+		for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+			parts[i], parts[j] = parts[j], parts[i]
+		}
+		for _, part := range parts {
+			for i, j := 0, len(part)-1; i < j; i, j = i+1, j-1 {
+				part[i], part[j] = part[j], part[i]
+			}
+		}
+		return parts
+	}
+
+	parts := []matrix.LineMatrix{}
+	lineStart := 0.0
+	for _, line := range lines {
+		length := lineLength(line)
+		lineEnd := lineStart + length
+		from, to := math.Max(start, lineStart), math.Min(end, lineEnd)
+		// the part of zero length on the joint of lines is dropped, unless the lengths are equal,
+		// then the part is taken only once.
+		if len(line) > 1 && (from < to || (from == to && start == end && len(parts) == 0)) {
+			parts = append(parts, subLine(line, from-lineStart, to-lineStart))
+		}
+		lineStart = lineEnd
+	}
+	return parts
+}
+
+// Project returns the length along the lines of the point on them nearest to the point,
+// the first one is returned if there are several nearest points.
+func Project(lines []matrix.LineMatrix, p matrix.Matrix) float64 {
+	index, lineStart := 0.0, 0.0
+	minDistance := math.Inf(1)
+	for _, line := range lines {
+		for i := 0; i < len(line)-1; i++ {
+			a, b := matrix.Matrix(line[i]), matrix.Matrix(line[i+1])
+			length := math.Hypot(b[0]-a[0], b[1]-a[1])
+			fraction := math.Max(0, math.Min(1, measure.ProjectionFactor(p, a, b)))
+			seg := &matrix.LineSegment{P0: a, P1: b}
+			nearest := seg.PointAlong(fraction)
+			if distance := math.Hypot(p[0]-nearest[0], p[1]-nearest[1]); distance < minDistance {
+				minDistance = distance
+				index = lineStart + fraction*length
+			}
+			lineStart += length
+		}
+	}
+	return index
+}
+
+// subLine returns the part of line between the two lengths from its start.
+func subLine(line matrix.LineMatrix, start, end float64) matrix.LineMatrix {
+	part := matrix.LineMatrix{}
+	add := func(p matrix.Matrix) {
+		if len(part) == 0 || !matrix.Matrix(part[len(part)-1]).Equals(p) {
+			part = append(part, p)
+		}
+	}
+	segStart := 0.0
+	for i := 0; i < len(line)-1; i++ {
+		seg := &matrix.LineSegment{P0: line[i], P1: line[i+1]}
+		length := math.Hypot(seg.P1[0]-seg.P0[0], seg.P1[1]-seg.P0[1])
+		segEnd := segStart + length
+		if len(part) == 0 && start <= segEnd && length > 0 {
+			add(seg.PointAlong((start - segStart) / length))
+		}
+		if len(part) > 0 {
+			if end <= segEnd && length > 0 {
+				add(seg.PointAlong((end - segStart) / length))
+				break
+			}
+			add(seg.P1)
+		}
+		segStart = segEnd
+	}
+	if len(part) == 1 {
+		part = append(part, part[0])
+	}
+	return part
+}
+
+// clampIndex returns the length from the start in the range of lines.
+func clampIndex(lines []matrix.LineMatrix, index float64) float64 {
+	length := Length(lines)
+	if index < 0 {
+		index += length
+	}
+	return math.Max(0, math.Min(length, index))
+}
+
+// lineLength returns the length of line.
+func lineLength(line matrix.LineMatrix) float64 {
+	length := 0.0
+	for i := 0; i < len(line)-1; i++ {
+		length += math.Hypot(line[i+1][0]-line[i][0], line[i+1][1]-line[i][1])
+	}
+	return length
+}
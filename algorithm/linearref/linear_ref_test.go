@@ -0,0 +1,85 @@
+package linearref
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+var (
+	line  = []matrix.LineMatrix{{{0, 0}, {10, 0}, {10, 10}}}
+	multi = []matrix.LineMatrix{{{0, 0}, {10, 0}}, {{20, 0}, {20, 10}}}
+)
+
+func TestExtractPoint(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []matrix.LineMatrix
+		index float64
+		want  matrix.Matrix
+	}{
+		{"start", line, 0, matrix.Matrix{0, 0}},
+		{"middle", line, 15, matrix.Matrix{10, 5}},
+		{"from end", line, -5, matrix.Matrix{10, 5}},
+		{"beyond end", line, 30, matrix.Matrix{10, 10}},
+		{"second line", multi, 12, matrix.Matrix{20, 2}},
+		{"empty", nil, 1, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractPoint(tt.lines, tt.index); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractPoint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		lines      []matrix.LineMatrix
+		start, end float64
+		want       []matrix.LineMatrix
+	}{
+		{"middle", line, 5, 15, []matrix.LineMatrix{{{5, 0}, {10, 0}, {10, 5}}}},
+		{"reversed", line, 15, 5, []matrix.LineMatrix{{{10, 5}, {10, 0}, {5, 0}}}},
+		{"from end", line, 0, -15, []matrix.LineMatrix{{{0, 0}, {5, 0}}}},
+		{"point", line, 10, 10, []matrix.LineMatrix{{{10, 0}, {10, 0}}}},
+		{"whole", line, 0, 20, []matrix.LineMatrix{{{0, 0}, {10, 0}, {10, 10}}}},
+		{"multi", multi, 5, 15, []matrix.LineMatrix{{{5, 0}, {10, 0}}, {{20, 0}, {20, 5}}}},
+		{"joint", multi, 10, 10, []matrix.LineMatrix{{{10, 0}, {10, 0}}}},
+		{"from joint", multi, 10, 12, []matrix.LineMatrix{{{20, 0}, {20, 2}}}},
+		{"reversed to joint", multi, 12, 10, []matrix.LineMatrix{{{20, 2}, {20, 0}}}},
+		{"to joint", multi, 8, 10, []matrix.LineMatrix{{{8, 0}, {10, 0}}}},
+		{"one point", []matrix.LineMatrix{{{1, 1}}}, 0, 1, []matrix.LineMatrix{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractLine(tt.lines, tt.start, tt.end); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractLine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProject(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []matrix.LineMatrix
+		p     matrix.Matrix
+		want  float64
+	}{
+		{"on line", line, matrix.Matrix{10, 5}, 15},
+		{"off line", line, matrix.Matrix{4, 3}, 4},
+		{"before start", line, matrix.Matrix{-5, -5}, 0},
+		{"multi", multi, matrix.Matrix{21, 4}, 14},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Project(tt.lines, tt.p); got != tt.want {
+				t.Errorf("Project() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package linearref
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// IsMeasured returns true if all the points of line carry the measure as the fourth ordinate {x, y, z, m}.
+func IsMeasured(line matrix.LineMatrix) bool {
+	if len(line) == 0 {
+		return false
+	}
+	for _, p := range line {
+		if len(p) < 4 {
+			return false
+		}
+	}
+	return true
+}
+
+// AddMeasure returns the line with the measures interpolated by the length from start to end,
+// the existing measures of line are replaced and the z of points is kept.
+func AddMeasure(line matrix.LineMatrix, start, end float64) matrix.LineMatrix {
+	if len(line) == 0 {
+		return nil
+	}
+	lengths := vertexLengths(line)
+	total := lengths[len(lengths)-1]
+	result := make(matrix.LineMatrix, len(line))
+	for i, p := range line {
+		m := start
+		if total > 0 {
+			m += (end - start) * lengths[i] / total
+		}
+		result[i] = withMeasure(p, m)
+	}
+	return result
+}
+
+// CalibrateMeasure returns the line with the measures interpolated by the length between the calibration points,
+// each calibration point carries its measure as the fourth ordinate and is located at the nearest point of line.
+// The measures before the first and after the last calibration point are extrapolated.
+// Returns nil if there are not two calibration points at the different locations.
+func CalibrateMeasure(line matrix.LineMatrix, points []matrix.Matrix) matrix.LineMatrix {
+	type calibration struct{ length, m float64 }
+	calibrations := []calibration{}
+	lines := []matrix.LineMatrix{line}
+	for _, p := range points {
+		if m := p.M(); !math.IsNaN(m) {
+			calibrations = append(calibrations, calibration{Project(lines, p), m})
+		}
+	}
+	sort.SliceStable(calibrations, func(i, j int) bool {
+		return calibrations[i].length < calibrations[j].length
+	})
+	// the first calibration point wins if there are several at the same location.
+	distinct := []calibration{}
+	for _, c := range calibrations {
+		if len(distinct) == 0 || c.length > distinct[len(distinct)-1].length {
+			distinct = append(distinct, c)
+		}
+	}
+	if len(distinct) < 2 {
+		return nil
+	}
+
+	lengths := vertexLengths(line)
+	result := make(matrix.LineMatrix, len(line))
+	for i, p := range line {
+		j := sort.Search(len(distinct)-1, func(k int) bool { return distinct[k+1].length >= lengths[i] })
+		if j == len(distinct)-1 {
+			j--
+		}
+		c0, c1 := distinct[j], distinct[j+1]
+		m := c0.m + (c1.m-c0.m)*(lengths[i]-c0.length)/(c1.length-c0.length)
+		result[i] = withMeasure(p, m)
+	}
+	return result
+}
+
+// LocateAlong returns the points of line where the measure is m, in the order along the line.
+// Both end points of the segment are returned if the measure is constant m on it.
+func LocateAlong(line matrix.LineMatrix, m float64) []matrix.Matrix {
+	points := []matrix.Matrix{}
+	add := func(p matrix.Matrix) {
+		if len(points) == 0 || !points[len(points)-1].Equals(p) {
+			points = append(points, p)
+		}
+	}
+	for i := 0; i < len(line)-1; i++ {
+		a, b := matrix.Matrix(line[i]), matrix.Matrix(line[i+1])
+		ma, mb := a.M(), b.M()
+		if ma == mb {
+			if ma == m {
+				add(a)
+				add(b)
+			}
+			continue
+		}
+		if t := (m - ma) / (mb - ma); t >= 0 && t <= 1 {
+			add(measurePoint(a, b, t))
+		}
+	}
+	return points
+}
+
+// LocateBetween returns the parts of line where the measure is between from and to, in the order along the line.
+// The measures may decrease along the line, the parts collapsed to a point are discarded.
+func LocateBetween(line matrix.LineMatrix, from, to float64) []matrix.LineMatrix {
+	if from > to {
+		from, to = to, from
+	}
+	parts := []matrix.LineMatrix{}
+	var current matrix.LineMatrix
+	flush := func() {
+		if len(current) > 1 {
+			parts = append(parts, current)
+		}
+		current = nil
+	}
+	for i := 0; i < len(line)-1; i++ {
+		a, b := matrix.Matrix(line[i]), matrix.Matrix(line[i+1])
+		ma, mb := a.M(), b.M()
+		lo, hi := 0.0, 1.0
+		if ma == mb {
+			if ma < from || ma > to {
+				flush()
+				continue
+			}
+		} else {
+			ta, tb := (from-ma)/(mb-ma), (to-ma)/(mb-ma)
+			lo, hi = math.Max(0, math.Min(ta, tb)), math.Min(1, math.Max(ta, tb))
+			if lo > hi {
+				flush()
+				continue
+			}
+		}
+		start, end := measurePoint(a, b, lo), measurePoint(a, b, hi)
+		if len(current) == 0 || !matrix.Matrix(current[len(current)-1]).Equals(start) {
+			flush()
+			current = matrix.LineMatrix{start}
+		}
+		if !start.Equals(end) {
+			current = append(current, end)
+		}
+		if hi < 1 {
+			flush()
+		}
+	}
+	flush()
+	return parts
+}
+
+// InterpolateMeasure returns the measure at the point of line nearest to the point,
+// the first one is used if there are several nearest points.
+func InterpolateMeasure(line matrix.LineMatrix, p matrix.Matrix) float64 {
+	if len(line) == 1 {
+		return matrix.Matrix(line[0]).M()
+	}
+	result, minDistance := math.NaN(), math.Inf(1)
+	for i := 0; i < len(line)-1; i++ {
+		a, b := matrix.Matrix(line[i]), matrix.Matrix(line[i+1])
+		fraction := 0.0
+		if !a.Equals(b) {
+			fraction = math.Max(0, math.Min(1, measure.ProjectionFactor(p, a, b)))
+		}
+		nearest := measurePoint(a, b, fraction)
+		if distance := math.Hypot(p[0]-nearest[0], p[1]-nearest[1]); distance < minDistance {
+			minDistance = distance
+			result = nearest.M()
+		}
+	}
+	return result
+}
+
+// measurePoint returns the point at the fraction of the segment ab with the interpolated z and measure.
+func measurePoint(a, b matrix.Matrix, t float64) matrix.Matrix {
+	switch t {
+	case 0:
+		return a
+	case 1:
+		return b
+	}
+	p := make(matrix.Matrix, len(a))
+	for i := range p {
+		p[i] = a[i] + t*(b[i]-a[i])
+	}
+	return p
+}
+
+// withMeasure returns the point {x, y, z, m} with the measure, the z of point is 0 if it has none.
+func withMeasure(p matrix.Matrix, m float64) matrix.Matrix {
+	z := 0.0
+	if len(p) > 2 {
+		z = p[2]
+	}
+	return matrix.Matrix{p[0], p[1], z, m}
+}
+
+// vertexLengths returns the length along the line at each point.
+func vertexLengths(line matrix.LineMatrix) []float64 {
+	lengths := make([]float64, len(line))
+	for i := 1; i < len(line); i++ {
+		lengths[i] = lengths[i-1] + math.Hypot(line[i][0]-line[i-1][0], line[i][1]-line[i-1][1])
+	}
+	return lengths
+}
@@ -0,0 +1,118 @@
+package linearref
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+var route = matrix.LineMatrix{{0, 0, 0, 100}, {10, 0, 0, 110}, {10, 10, 0, 120}}
+
+func TestAddMeasure(t *testing.T) {
+	want := matrix.LineMatrix{{0, 0, 0, 0}, {10, 0, 0, 50}, {10, 10, 0, 100}}
+	if got := AddMeasure(line[0], 0, 100); !reflect.DeepEqual(got, want) {
+		t.Errorf("AddMeasure() = %v, want %v", got, want)
+	}
+	if !IsMeasured(want) || IsMeasured(line[0]) {
+		t.Errorf("IsMeasured() is wrong")
+	}
+
+	line3D := matrix.LineMatrix{{0, 0, 5}, {10, 0, 7}}
+	want = matrix.LineMatrix{{0, 0, 5, 0}, {10, 0, 7, 100}}
+	if got := AddMeasure(line3D, 0, 100); !reflect.DeepEqual(got, want) || IsMeasured(line3D) {
+		t.Errorf("AddMeasure() = %v, want %v", got, want)
+	}
+	if got := LocateAlong(want, 50); !reflect.DeepEqual(got, []matrix.Matrix{{5, 0, 6, 50}}) {
+		t.Errorf("LocateAlong() = %v, want %v", got, []matrix.Matrix{{5, 0, 6, 50}})
+	}
+}
+
+func TestCalibrateMeasure(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []matrix.Matrix
+		want   matrix.LineMatrix
+	}{
+		{"interpolated", []matrix.Matrix{{0, 0, 0, 0}, {10, 10, 0, 40}}, matrix.LineMatrix{{0, 0, 0, 0}, {10, 0, 0, 20}, {10, 10, 0, 40}}},
+		{"extrapolated", []matrix.Matrix{{12, 5, 0, 30}, {5, -1, 0, 10}}, matrix.LineMatrix{{0, 0, 0, 0}, {10, 0, 0, 20}, {10, 10, 0, 40}}},
+		{"piecewise", []matrix.Matrix{{0, 0, 0, 0}, {10, 0, 0, 10}, {10, 10, 0, 30}}, matrix.LineMatrix{{0, 0, 0, 0}, {10, 0, 0, 10}, {10, 10, 0, 30}}},
+		{"one location", []matrix.Matrix{{0, 0, 0, 0}, {0, 0, 0, 10}}, nil},
+		{"no measure", []matrix.Matrix{{0, 0}, {10, 10}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalibrateMeasure(line[0], tt.points); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CalibrateMeasure() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocateAlong(t *testing.T) {
+	tests := []struct {
+		name string
+		line matrix.LineMatrix
+		m    float64
+		want []matrix.Matrix
+	}{
+		{"middle", route, 115, []matrix.Matrix{{10, 5, 0, 115}}},
+		{"vertex", route, 110, []matrix.Matrix{{10, 0, 0, 110}}},
+		{"outside", route, 130, []matrix.Matrix{}},
+		{"decreasing", matrix.LineMatrix{{0, 0, 0, 0}, {10, 0, 0, 10}, {20, 0, 0, 0}}, 5, []matrix.Matrix{{5, 0, 0, 5}, {15, 0, 0, 5}}},
+		{"constant", matrix.LineMatrix{{0, 0, 0, 0}, {10, 0, 0, 5}, {20, 0, 0, 5}}, 5, []matrix.Matrix{{10, 0, 0, 5}, {20, 0, 0, 5}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LocateAlong(tt.line, tt.m); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LocateAlong() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocateBetween(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     matrix.LineMatrix
+		from, to float64
+		want     []matrix.LineMatrix
+	}{
+		{"middle", route, 105, 115, []matrix.LineMatrix{{{5, 0, 0, 105}, {10, 0, 0, 110}, {10, 5, 0, 115}}}},
+		{"reversed range", route, 115, 105, []matrix.LineMatrix{{{5, 0, 0, 105}, {10, 0, 0, 110}, {10, 5, 0, 115}}}},
+		{"whole", route, 0, 200, []matrix.LineMatrix{route}},
+		{"outside", route, 130, 140, []matrix.LineMatrix{}},
+		{"decreasing", matrix.LineMatrix{{0, 0, 0, 0}, {10, 0, 0, 10}, {20, 0, 0, 0}}, 8, 20,
+			[]matrix.LineMatrix{{{8, 0, 0, 8}, {10, 0, 0, 10}, {12, 0, 0, 8}}}},
+		{"two parts", matrix.LineMatrix{{0, 0, 0, 0}, {10, 0, 0, 10}, {20, 0, 0, 0}}, 0, 2,
+			[]matrix.LineMatrix{{{0, 0, 0, 0}, {2, 0, 0, 2}}, {{18, 0, 0, 2}, {20, 0, 0, 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LocateBetween(tt.line, tt.from, tt.to); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LocateBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterpolateMeasure(t *testing.T) {
+	tests := []struct {
+		name string
+		line matrix.LineMatrix
+		p    matrix.Matrix
+		want float64
+	}{
+		{"on line", route, matrix.Matrix{10, 5}, 115},
+		{"off line", route, matrix.Matrix{4, -3}, 104},
+		{"beyond end", route, matrix.Matrix{10, 20}, 120},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InterpolateMeasure(tt.line, tt.p); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("InterpolateMeasure() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
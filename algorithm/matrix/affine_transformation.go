@@ -0,0 +1,199 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm"
+)
+
+// AffineTransformation is the affine transformation of 3D space, which is stored as the 3x4 matrix
+//
+//	| m00 m01 m02 m03 |
+//	| m10 m11 m12 m13 |
+//	| m20 m21 m22 m23 |
+//
+// it transforms the point (x, y, z) to (m00x + m01y + m02z + m03, m10x + m11y + m12z + m13, m20x + m21y + m22z + m23).
+// The 2D transformations keep z, so the third ordinate of point, e.g. the measure, is not changed by them.
+// The transformations are composed by the methods, e.g. t.Scale(2, 2).Translate(1, 1) scales first and then translates.
+type AffineTransformation struct {
+	m [3][4]float64
+}
+
+// NewAffineTransformation returns the identity transformation.
+func NewAffineTransformation() *AffineTransformation {
+	return &AffineTransformation{m: [3][4]float64{{1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}}}
+}
+
+// NewAffineTransformation2D returns the 2D transformation of (x, y) to (m00x + m01y + m02, m10x + m11y + m12).
+func NewAffineTransformation2D(m00, m01, m02, m10, m11, m12 float64) *AffineTransformation {
+	return &AffineTransformation{m: [3][4]float64{{m00, m01, 0, m02}, {m10, m11, 0, m12}, {0, 0, 1, 0}}}
+}
+
+// NewAffineTransformation3D returns the 3D transformation of the 3x4 matrix.
+func NewAffineTransformation3D(m [3][4]float64) *AffineTransformation {
+	return &AffineTransformation{m: m}
+}
+
+// Matrix returns the 3x4 matrix of transformation.
+func (t *AffineTransformation) Matrix() [3][4]float64 {
+	return t.m
+}
+
+// Compose composes the other transformation after this transformation, returns this transformation.
+func (t *AffineTransformation) Compose(other *AffineTransformation) *AffineTransformation {
+	result := [3][4]float64{}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 4; j++ {
+			for k := 0; k < 3; k++ {
+				result[i][j] += other.m[i][k] * t.m[k][j]
+			}
+		}
+		result[i][3] += other.m[i][3]
+	}
+	t.m = result
+	return t
+}
+
+// Translate composes the translation by dx and dy.
+func (t *AffineTransformation) Translate(dx, dy float64) *AffineTransformation {
+	return t.Translate3D(dx, dy, 0)
+}
+
+// Translate3D composes the translation by dx, dy and dz.
+func (t *AffineTransformation) Translate3D(dx, dy, dz float64) *AffineTransformation {
+	return t.Compose(&AffineTransformation{m: [3][4]float64{{1, 0, 0, dx}, {0, 1, 0, dy}, {0, 0, 1, dz}}})
+}
+
+// Rotate composes the counter-clockwise rotation by the angle in radians about the origin.
+func (t *AffineTransformation) Rotate(theta float64) *AffineTransformation {
+	sin, cos := math.Sincos(theta)
+	return t.Compose(NewAffineTransformation2D(cos, -sin, 0, sin, cos, 0))
+}
+
+// RotateAround composes the counter-clockwise rotation by the angle in radians about the point (x, y).
+func (t *AffineTransformation) RotateAround(theta, x, y float64) *AffineTransformation {
+	return t.Translate(-x, -y).Rotate(theta).Translate(x, y)
+}
+
+// Scale composes the scaling by sx and sy about the origin.
+func (t *AffineTransformation) Scale(sx, sy float64) *AffineTransformation {
+	return t.Scale3D(sx, sy, 1)
+}
+
+// ScaleAround composes the scaling by sx and sy about the point (x, y).
+func (t *AffineTransformation) ScaleAround(sx, sy, x, y float64) *AffineTransformation {
+	return t.Translate(-x, -y).Scale(sx, sy).Translate(x, y)
+}
+
+// Scale3D composes the scaling by sx, sy and sz about the origin.
+func (t *AffineTransformation) Scale3D(sx, sy, sz float64) *AffineTransformation {
+	return t.Compose(&AffineTransformation{m: [3][4]float64{{sx, 0, 0, 0}, {0, sy, 0, 0}, {0, 0, sz, 0}}})
+}
+
+// Reflect composes the reflection about the line through the points (x0, y0) and (x1, y1),
+// nothing is composed if the points are identical.
+func (t *AffineTransformation) Reflect(x0, y0, x1, y1 float64) *AffineTransformation {
+	dx, dy := x1-x0, y1-y0
+	length2 := dx*dx + dy*dy
+	if length2 == 0 {
+		return t
+	}
+	// the reflection about the line of direction angle a is the matrix of angle 2a.
+	cos2, sin2 := (dx*dx-dy*dy)/length2, 2*dx*dy/length2
+	return t.Translate(-x0, -y0).Compose(NewAffineTransformation2D(cos2, sin2, 0, sin2, -cos2, 0)).Translate(x0, y0)
+}
+
+// Shear composes the shearing of (x, y) to (x + shx*y, shy*x + y).
+func (t *AffineTransformation) Shear(shx, shy float64) *AffineTransformation {
+	return t.Compose(NewAffineTransformation2D(1, shx, 0, shy, 1, 0))
+}
+
+// Determinant returns the determinant of the linear part of transformation,
+// the transformation is invertible if it is not zero.
+func (t *AffineTransformation) Determinant() float64 {
+	m := t.m
+	return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+}
+
+// Inverse returns the inverse transformation, returns error if the transformation is not invertible.
+func (t *AffineTransformation) Inverse() (*AffineTransformation, error) {
+	det := t.Determinant()
+	if det == 0 || math.IsNaN(det) {
+		return nil, algorithm.ErrNotInvertible
+	}
+	m := t.m
+	inv := [3][4]float64{}
+	// the inverse of linear part is the adjugate divided by the determinant.
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r0, r1 := (j+1)%3, (j+2)%3
+			c0, c1 := (i+1)%3, (i+2)%3
+			inv[i][j] = (m[r0][c0]*m[r1][c1] - m[r0][c1]*m[r1][c0]) / det
+		}
+	}
+	for i := 0; i < 3; i++ {
+		inv[i][3] = -(inv[i][0]*m[0][3] + inv[i][1]*m[1][3] + inv[i][2]*m[2][3])
+	}
+	return &AffineTransformation{m: inv}, nil
+}
+
+// IsIdentity returns true if the transformation does not change any point.
+func (t *AffineTransformation) IsIdentity() bool {
+	return t.m == NewAffineTransformation().m
+}
+
+// TransformMatrix returns the transformed point, the point of two ordinates is transformed in the plane z = 0.
+func (t *AffineTransformation) TransformMatrix(p Matrix) Matrix {
+	if len(p) < 2 {
+		return p
+	}
+	z := 0.0
+	if len(p) > 2 {
+		z = p[2]
+	}
+	result := append(Matrix{}, p...)
+	for i := 0; i < len(p) && i < 3; i++ {
+		result[i] = t.m[i][0]*p[0] + t.m[i][1]*p[1] + t.m[i][2]*z + t.m[i][3]
+	}
+	return result
+}
+
+// Transform returns the transformed copy of the steric.
+func (t *AffineTransformation) Transform(s Steric) Steric {
+	return TransformSteric(s, t.TransformMatrix)
+}
+
+// TransformSteric returns the copy of the steric with each point transformed by the function.
+func TransformSteric(s Steric, transform func(p Matrix) Matrix) Steric {
+	switch m := s.(type) {
+	case Matrix:
+		return transform(m)
+	case LineMatrix:
+		line := make(LineMatrix, 0, len(m))
+		for _, p := range m {
+			line = append(line, transform(p))
+		}
+		return line
+	case PolygonMatrix:
+		poly := make(PolygonMatrix, 0, len(m))
+		for _, ring := range m {
+			poly = append(poly, TransformSteric(LineMatrix(ring), transform).(LineMatrix))
+		}
+		return poly
+	case MultiPolygonMatrix:
+		multi := make(MultiPolygonMatrix, 0, len(m))
+		for _, poly := range m {
+			multi = append(multi, TransformSteric(PolygonMatrix(poly), transform).(PolygonMatrix))
+		}
+		return multi
+	case Collection:
+		coll := make(Collection, 0, len(m))
+		for _, v := range m {
+			coll = append(coll, TransformSteric(v, transform))
+		}
+		return coll
+	}
+	return s
+}
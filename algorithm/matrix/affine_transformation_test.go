@@ -0,0 +1,73 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAffineTransformation_Transform(t *testing.T) {
+	tests := []struct {
+		name string
+		t    *AffineTransformation
+		s    Steric
+		want Steric
+	}{
+		{"identity", NewAffineTransformation(), Matrix{1, 2}, Matrix{1, 2}},
+		{"translate", NewAffineTransformation().Translate(1, -1), LineMatrix{{0, 0}, {1, 1}}, LineMatrix{{1, -1}, {2, 0}}},
+		{"rotate", NewAffineTransformation().Rotate(math.Pi / 2), Matrix{1, 0}, Matrix{0, 1}},
+		{"rotate around", NewAffineTransformation().RotateAround(math.Pi, 1, 1), Matrix{0, 0}, Matrix{2, 2}},
+		{"scale around", NewAffineTransformation().ScaleAround(2, 3, 1, 1), Matrix{2, 2}, Matrix{3, 4}},
+		{"reflect", NewAffineTransformation().Reflect(0, 0, 1, 1), Matrix{2, 0}, Matrix{0, 2}},
+		{"reflect offset", NewAffineTransformation().Reflect(0, 1, 1, 1), Matrix{3, 3}, Matrix{3, -1}},
+		{"shear", NewAffineTransformation().Shear(2, 0), Matrix{1, 1}, Matrix{3, 1}},
+		{"composed", NewAffineTransformation().Scale(2, 2).Translate(1, 1), Matrix{1, 1}, Matrix{3, 3}},
+		{"measure kept", NewAffineTransformation().Translate(1, 1), Matrix{1, 1, 5}, Matrix{2, 2, 5}},
+		{"3D", NewAffineTransformation().Translate3D(1, 1, 1).Scale3D(1, 1, 2), Matrix{1, 1, 1}, Matrix{2, 2, 4}},
+		{"polygon", NewAffineTransformation().Translate(1, 0), PolygonMatrix{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}},
+			PolygonMatrix{{{1, 0}, {2, 0}, {1, 1}, {1, 0}}}},
+		{"collection", NewAffineTransformation().Translate(1, 0), Collection{Matrix{0, 0}, LineMatrix{{0, 0}, {1, 1}}},
+			Collection{Matrix{1, 0}, LineMatrix{{1, 0}, {2, 1}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.t.Transform(tt.s)
+			if !got.EqualsExact(tt.want, 1e-9) {
+				t.Errorf("Transform() = %v, want %v", got, tt.want)
+			}
+			if want, ok := tt.want.(Matrix); ok && len(want) > 2 && got.(Matrix)[2] != want[2] {
+				t.Errorf("Transform() z = %v, want %v", got.(Matrix)[2], want[2])
+			}
+		})
+	}
+}
+
+func TestAffineTransformation_Inverse(t *testing.T) {
+	tr := NewAffineTransformation().Scale3D(2, 3, 4).RotateAround(0.5, 1, 2).Shear(0.3, 0.1).Translate3D(5, 6, 7)
+	inv, err := tr.Inverse()
+	if err != nil {
+		t.Fatalf("Inverse() error = %v", err)
+	}
+	p := Matrix{1.5, -2, 3}
+	if got := inv.TransformMatrix(tr.TransformMatrix(p)); math.Abs(got[0]-p[0]) > 1e-9 ||
+		math.Abs(got[1]-p[1]) > 1e-9 || math.Abs(got[2]-p[2]) > 1e-9 {
+		t.Errorf("Inverse() transforms back to %v, want %v", got, p)
+	}
+	if !tr.Compose(inv).IsIdentity() && !isNearIdentity(tr) {
+		t.Errorf("Compose() with inverse = %v, want identity", tr.Matrix())
+	}
+	if _, err := NewAffineTransformation().Scale(0, 1).Inverse(); err == nil {
+		t.Errorf("Inverse() should return error if the transformation is not invertible")
+	}
+}
+
+func isNearIdentity(t *AffineTransformation) bool {
+	identity := NewAffineTransformation().Matrix()
+	for i, row := range t.Matrix() {
+		for j, v := range row {
+			if math.Abs(v-identity[i][j]) > 1e-9 {
+				return false
+			}
+		}
+	}
+	return true
+}
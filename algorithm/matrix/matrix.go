@@ -150,6 +150,15 @@ func (m Matrix) IsEmpty() bool {
 	return len(m) == 0
 }
 
+// M returns the measure of the point, which is carried as the fourth ordinate {x, y, z, m},
+// the z of the measured 2D point is 0. Returns NaN if the point has no measure.
+func (m Matrix) M() float64 {
+	if len(m) < 4 {
+		return math.NaN()
+	}
+	return m[3]
+}
+
 // Bound returns a single point bound of the point.
 func (m Matrix) Bound() Bound {
 	return []Matrix{m, m}
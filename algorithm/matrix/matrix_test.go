@@ -1,6 +1,7 @@
 package matrix
 
 import (
+	"math"
 	"reflect"
 	"testing"
 )
@@ -167,6 +168,15 @@ func TestMatrix_Compare(t *testing.T) {
 	}
 }
 
+func TestMatrix_M(t *testing.T) {
+	if got := (Matrix{1, 2, 3, 4}).M(); got != 4 {
+		t.Errorf("Matrix.M() = %v, want %v", got, 4)
+	}
+	if got := (Matrix{1, 2, 3}).M(); !math.IsNaN(got) {
+		t.Errorf("Matrix.M() = %v, want NaN", got)
+	}
+}
+
 func TestTransMatrixes(t *testing.T) {
 	type args struct {
 		inputGeom Steric
@@ -0,0 +1,119 @@
+package measure
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// ClosestPoints returns the planar distance of the geometries and their closest points,
+// the first point is on from and the second one is on to, as computed by PlanarDistance.
+// Returns nil points if any geometry is empty.
+func ClosestPoints(fromSteric, toSteric matrix.Steric) (float64, []matrix.Matrix) {
+	if fromSteric == nil || toSteric == nil || fromSteric.IsEmpty() || toSteric.IsEmpty() {
+		return 0, nil
+	}
+	locMatrix := []matrix.Matrix{nil, nil}
+	dist := distanceCompute(fromSteric, toSteric, locMatrix)
+	if locMatrix[0] == nil || locMatrix[1] == nil {
+		return 0, nil
+	}
+	return dist, locMatrix
+}
+
+// DWithin returns true if the planar distance of the geometries is not larger than distance.
+// The parts of geometries whose envelopes are farther than distance are not compared,
+// the others are compared by PlanarDistance.
+func DWithin(fromSteric, toSteric matrix.Steric, distance float64) bool {
+	if distance < 0 {
+		return false
+	}
+	locMatrix := []matrix.Matrix{{0, 0}, {0, 0}}
+	for _, from := range distanceParts(fromSteric, nil) {
+		for _, to := range distanceParts(toSteric, nil) {
+			// the slack keeps the parts whose distance rounds to the one of envelopes.
+			if boundDistance(from.bound, to.bound) > distance*(1+calc.DefaultTolerance) {
+				continue
+			}
+			if distanceCompute(from.steric, to.steric, locMatrix) <= distance {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DWithinInMeter returns true if the spheroid distance of the geometries of longitude and latitude
+// is not larger than distance in meter, the distance of parts is the one of SpheroidDistance.
+// The parts of geometries whose envelopes are apart in latitude farther than distance are not compared,
+// and it returns once any two parts are found within distance.
+func DWithinInMeter(fromSteric, toSteric matrix.Steric, distance float64) bool {
+	if distance < 0 {
+		return false
+	}
+	rad := math.Pi / 180
+	for _, from := range distanceParts(fromSteric, nil) {
+		for _, to := range distanceParts(toSteric, nil) {
+			// the arc of meridian between the latitudes is not longer than the distance on the sphere.
+			gap := math.Max(from.bound[0][1]-to.bound[1][1], to.bound[0][1]-from.bound[1][1])
+			if gap*rad*R > distance {
+				continue
+			}
+			if SpheroidDistance(from.steric, to.steric) <= distance {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// distancePart is a point, line or polygon of geometry with its envelope.
+type distancePart struct {
+	steric matrix.Steric
+	bound  matrix.Bound
+}
+
+// distanceParts appends the points, lines and polygons of the geometry to parts.
+func distanceParts(m matrix.Steric, parts []*distancePart) []*distancePart {
+	switch mm := m.(type) {
+	case matrix.Matrix:
+		if len(mm) >= 2 {
+			parts = append(parts, newDistancePart(mm, matrix.LineMatrix{mm}))
+		}
+	case matrix.LineMatrix:
+		if len(mm) > 0 {
+			parts = append(parts, newDistancePart(mm, mm))
+		}
+	case matrix.PolygonMatrix:
+		if len(mm) > 0 && len(mm[0]) > 0 {
+			parts = append(parts, newDistancePart(mm, mm[0]))
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, poly := range mm {
+			parts = distanceParts(matrix.PolygonMatrix(poly), parts)
+		}
+	case matrix.Collection:
+		for _, v := range mm {
+			parts = distanceParts(v, parts)
+		}
+	}
+	return parts
+}
+
+// newDistancePart returns the part with the envelope of its points, the shell is the points of polygon.
+func newDistancePart(m matrix.Steric, points matrix.LineMatrix) *distancePart {
+	bound := matrix.Bound{{math.MaxFloat64, math.MaxFloat64}, {-math.MaxFloat64, -math.MaxFloat64}}
+	for _, p := range points {
+		bound[0][0], bound[0][1] = math.Min(bound[0][0], p[0]), math.Min(bound[0][1], p[1])
+		bound[1][0], bound[1][1] = math.Max(bound[1][0], p[0]), math.Max(bound[1][1], p[1])
+	}
+	return &distancePart{steric: m, bound: bound}
+}
+
+// boundDistance returns the distance of the envelopes, it is 0 if they intersect.
+func boundDistance(b0, b1 matrix.Bound) float64 {
+	dx := math.Max(0, math.Max(b0[0][0]-b1[1][0], b1[0][0]-b0[1][0]))
+	dy := math.Max(0, math.Max(b0[0][1]-b1[1][1], b1[0][1]-b0[1][1]))
+	return math.Hypot(dx, dy)
+}
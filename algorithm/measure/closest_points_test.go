@@ -0,0 +1,116 @@
+package measure
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestClosestPoints(t *testing.T) {
+	poly := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}}
+	tests := []struct {
+		name   string
+		from   matrix.Steric
+		to     matrix.Steric
+		want   float64
+		points []matrix.Matrix
+	}{
+		{name: "point point", from: matrix.Matrix{0, 0}, to: matrix.Matrix{3, 4}, want: 5,
+			points: []matrix.Matrix{{0, 0}, {3, 4}}},
+		{name: "point line", from: matrix.Matrix{1, 2}, to: matrix.LineMatrix{{0, 0}, {4, 0}}, want: 2,
+			points: []matrix.Matrix{{1, 2}, {1, 0}}},
+		{name: "line point", from: matrix.LineMatrix{{0, 0}, {4, 0}}, to: matrix.Matrix{1, 2}, want: 2,
+			points: []matrix.Matrix{{1, 0}, {1, 2}}},
+		{name: "line line", from: matrix.LineMatrix{{0, 0}, {4, 0}}, to: matrix.LineMatrix{{2, 3}, {2, 1}}, want: 1,
+			points: []matrix.Matrix{{2, 0}, {2, 1}}},
+		{name: "line cross", from: matrix.LineMatrix{{0, 0}, {4, 4}}, to: matrix.LineMatrix{{0, 4}, {4, 0}}, want: 0,
+			points: []matrix.Matrix{{2, 2}, {2, 2}}},
+		{name: "point in polygon", from: matrix.Matrix{2, 2}, to: poly, want: 0,
+			points: []matrix.Matrix{{2, 2}, {2, 2}}},
+		{name: "point in hole", from: matrix.Matrix{5, 4.5}, to: poly, want: 0.5,
+			points: []matrix.Matrix{{5, 4.5}, {5, 4}}},
+		{name: "polygon line", from: poly, to: matrix.LineMatrix{{12, 5}, {15, 5}}, want: 2,
+			points: []matrix.Matrix{{10, 5}, {12, 5}}},
+		{name: "collection", from: matrix.Collection{matrix.Matrix{20, 20}, matrix.Matrix{0, 13}}, to: poly, want: 3,
+			points: []matrix.Matrix{{0, 13}, {0, 10}}},
+		{name: "line polygon", from: matrix.LineMatrix{{12, 5}, {15, 5}}, to: poly, want: 2,
+			points: []matrix.Matrix{{12, 5}, {10, 5}}},
+		{name: "polygon in polygon", from: matrix.PolygonMatrix{{{1, 1}, {2, 1}, {2, 2}, {1, 1}}}, to: poly, want: 0,
+			points: []matrix.Matrix{{1, 1}, {1, 1}}},
+		{name: "multipolygon", from: matrix.Matrix{13, 5}, to: matrix.MultiPolygonMatrix{poly, {{{20, 0}, {30, 0}, {30, 10}, {20, 0}}}},
+			want: 3, points: []matrix.Matrix{{13, 5}, {10, 5}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, points := ClosestPoints(tt.from, tt.to)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("ClosestPoints() distance = %v, want %v", got, tt.want)
+			}
+			if len(points) != 2 || !points[0].Proximity(tt.points[0]) || !points[1].Proximity(tt.points[1]) {
+				t.Errorf("ClosestPoints() points = %v, want %v", points, tt.points)
+			}
+			if dist := PlanarDistance(tt.from, tt.to); got != dist {
+				t.Errorf("ClosestPoints() distance = %v, PlanarDistance %v", got, dist)
+			}
+		})
+	}
+	if _, points := ClosestPoints(matrix.LineMatrix{}, matrix.Matrix{1, 1}); points != nil {
+		t.Errorf("ClosestPoints() of empty = %v, want nil", points)
+	}
+}
+
+func TestDWithin(t *testing.T) {
+	poly := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name     string
+		from     matrix.Steric
+		to       matrix.Steric
+		distance float64
+		want     bool
+	}{
+		{name: "point point within", from: matrix.Matrix{0, 0}, to: matrix.Matrix{3, 4}, distance: 5, want: true},
+		{name: "point point beyond", from: matrix.Matrix{0, 0}, to: matrix.Matrix{3, 4}, distance: 4.9, want: false},
+		{name: "point in polygon", from: matrix.Matrix{5, 5}, to: poly, distance: 0, want: true},
+		{name: "line near polygon", from: matrix.LineMatrix{{11, -5}, {11, 15}}, to: poly, distance: 1, want: true},
+		{name: "line far from polygon", from: matrix.LineMatrix{{11, -5}, {11, 15}}, to: poly, distance: 0.5, want: false},
+		{name: "envelope near but far", from: matrix.LineMatrix{{9, 20}, {20, 9}}, to: poly, distance: 2, want: false},
+		{name: "multipoint at its distance", from: matrix.Collection{matrix.Matrix{0, 0}, matrix.Matrix{10, 0}}, to: matrix.Matrix{5, 1},
+			distance: PlanarDistance(matrix.Collection{matrix.Matrix{0, 0}, matrix.Matrix{10, 0}}, matrix.Matrix{5, 1}), want: true},
+		{name: "negative", from: matrix.Matrix{5, 5}, to: poly, distance: -1, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DWithin(tt.from, tt.to, tt.distance); got != tt.want {
+				t.Errorf("DWithin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDWithinInMeter(t *testing.T) {
+	fromPoint := matrix.Matrix{12, 15}
+	toPoint := matrix.Matrix{13, 15}
+	line0 := matrix.LineMatrix{{116.40495300292967, 39.926785883895654}, {116.3975715637207, 39.9295502919}}
+	line1 := matrix.LineMatrix{{116.37310981750488, 39.92099342895789}, {116.39928817749023, 39.9174387253541}}
+	tests := []struct {
+		name     string
+		from     matrix.Steric
+		to       matrix.Steric
+		distance float64
+		want     bool
+	}{
+		{name: "point within", from: fromPoint, to: toPoint, distance: 107500, want: true},
+		{name: "point beyond", from: fromPoint, to: toPoint, distance: 107000, want: false},
+		{name: "line within", from: line0, to: line1, distance: 1200, want: true},
+		{name: "line beyond", from: line0, to: line1, distance: 1100, want: false},
+		{name: "latitude apart", from: fromPoint, to: matrix.Matrix{12, 16}, distance: 100000, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DWithinInMeter(tt.from, tt.to, tt.distance); got != tt.want {
+				t.Errorf("DWithinInMeter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -69,7 +69,8 @@ func PlanarDistance(fromSteric, toSteric matrix.Steric) float64 {
 	return distanceCompute(fromSteric, toSteric, locMatrix)
 }
 
-// distanceCompute returns Distance of form to.
+// distanceCompute returns Distance of form to, locMatrix is set to the closest points on from and on to.
+// The distance is 0 if a point of one geometry is inside the polygon of the other one.
 func distanceCompute(fromSteric, toSteric matrix.Steric, locMatrix []matrix.Matrix) float64 {
 	switch to := toSteric.(type) {
 	case matrix.Matrix:
@@ -77,7 +78,7 @@ func distanceCompute(fromSteric, toSteric matrix.Steric, locMatrix []matrix.Matr
 			locMatrix[0], locMatrix[1] = from, to
 			return math.Sqrt((from[0]-to[0])*(from[0]-to[0]) + (from[1]-to[1])*(from[1]-to[1]))
 		}
-		return distanceCompute(to, fromSteric, locMatrix)
+		return distanceReversed(fromSteric, to, locMatrix)
 
 	case matrix.LineMatrix:
 		if from, ok := fromSteric.(matrix.Matrix); ok {
@@ -85,13 +86,17 @@ func distanceCompute(fromSteric, toSteric matrix.Steric, locMatrix []matrix.Matr
 		} else if from, ok := fromSteric.(matrix.LineMatrix); ok {
 			return distanceLineAndLine(from, to, locMatrix)
 		}
-		return distanceCompute(to, fromSteric, locMatrix)
+		return distanceReversed(fromSteric, to, locMatrix)
 	case matrix.PolygonMatrix:
 		if from, ok := fromSteric.(matrix.Matrix); ok {
 			return distancePolygonToPoint(to, from, locMatrix)
 		} else if from, ok := fromSteric.(matrix.LineMatrix); ok {
 			return distancePolygonAndLine(to, from, locMatrix)
 		} else if from, ok := fromSteric.(matrix.PolygonMatrix); ok {
+			if len(to) > 0 && len(to[0]) > 0 && inPolygon(from, to[0][0]) {
+				locMatrix[0], locMatrix[1] = to[0][0], to[0][0]
+				return 0
+			}
 			dist := math.MaxFloat64
 			loc := []matrix.Matrix{{0, 0}, {0, 0}}
 			for _, v := range from {
@@ -102,7 +107,17 @@ func distanceCompute(fromSteric, toSteric matrix.Steric, locMatrix []matrix.Matr
 			}
 			return dist
 		}
-		return PlanarDistance(to, fromSteric)
+		return distanceReversed(fromSteric, to, locMatrix)
+	case matrix.MultiPolygonMatrix:
+		dist := math.MaxFloat64
+		loc := []matrix.Matrix{{0, 0}, {0, 0}}
+		for _, v := range to {
+			if distP := distanceCompute(fromSteric, matrix.PolygonMatrix(v), loc); dist > distP {
+				locMatrix[0], locMatrix[1] = loc[0], loc[1]
+				dist = distP
+			}
+		}
+		return dist
 	case matrix.Collection:
 		dist := math.MaxFloat64
 		loc := []matrix.Matrix{{0, 0}, {0, 0}}
@@ -118,6 +133,13 @@ func distanceCompute(fromSteric, toSteric matrix.Steric, locMatrix []matrix.Matr
 	}
 }
 
+// distanceReversed returns Distance of to from, locMatrix is kept in the order of from and to.
+func distanceReversed(fromSteric, toSteric matrix.Steric, locMatrix []matrix.Matrix) float64 {
+	dist := distanceCompute(toSteric, fromSteric, locMatrix)
+	locMatrix[0], locMatrix[1] = locMatrix[1], locMatrix[0]
+	return dist
+}
+
 // distanceSegmentToPoint Returns Distance of p,ab
 func distanceSegmentToPoint(p, a, b matrix.Matrix) float64 {
 	// if start = end, then just compute distance to one of the endpoints
@@ -161,18 +183,32 @@ func distanceSegmentToPoint(p, a, b matrix.Matrix) float64 {
 	return math.Abs(s) * math.Sqrt(len2)
 }
 
-// distanceLineToPoint Returns Distance of p,line
+// closestPointOnSegment returns the point on segment ab closest to p.
+func closestPointOnSegment(p, a, b matrix.Matrix) matrix.Matrix {
+	len2 := (b[0]-a[0])*(b[0]-a[0]) + (b[1]-a[1])*(b[1]-a[1])
+	if len2 == 0 {
+		return a
+	}
+	r := ((p[0]-a[0])*(b[0]-a[0]) + (p[1]-a[1])*(b[1]-a[1])) / len2
+	if r <= 0.0 {
+		return a
+	}
+	if r >= 1.0 {
+		return b
+	}
+	return matrix.Matrix{a[0] + r*(b[0]-a[0]), a[1] + r*(b[1]-a[1])}
+}
+
+// distanceLineToPoint Returns Distance of p,line, locMatrix is set to the point and its closest point on line.
 func distanceLineToPoint(line matrix.LineMatrix, pt matrix.Matrix, locMatrix []matrix.Matrix) (dist float64) {
 	dist = math.MaxFloat64
+	if len(line) == 1 {
+		return distanceCompute(pt, matrix.Matrix(line[0]), locMatrix)
+	}
 	for i, v := range line {
 		if i < len(line)-1 {
 			if tmpDist := distanceSegmentToPoint(pt, v, line[i+1]); dist > tmpDist {
-				locMatrix[0] = pt
-				if PlanarDistance(pt, matrix.Matrix(v)) < PlanarDistance(pt, matrix.Matrix(line[i+1])) {
-					locMatrix[1] = v
-				} else {
-					locMatrix[1] = line[i+1]
-				}
+				locMatrix[0], locMatrix[1] = pt, closestPointOnSegment(pt, v, line[i+1])
 				dist = tmpDist
 			}
 		}
@@ -180,8 +216,12 @@ func distanceLineToPoint(line matrix.LineMatrix, pt matrix.Matrix, locMatrix []m
 	return
 }
 
-// distancePolygonToPoint Returns Distance of p,polygon
+// distancePolygonToPoint Returns Distance of p,polygon, locMatrix is set to the point and its closest point on polygon.
 func distancePolygonToPoint(poly matrix.PolygonMatrix, pt matrix.Matrix, locMatrix []matrix.Matrix) (dist float64) {
+	if inPolygon(poly, pt) {
+		locMatrix[0], locMatrix[1] = pt, pt
+		return 0
+	}
 	dist = math.MaxFloat64
 	loc := []matrix.Matrix{{0, 0}, {0, 0}}
 	for _, v := range poly {
@@ -194,11 +234,13 @@ func distancePolygonToPoint(poly matrix.PolygonMatrix, pt matrix.Matrix, locMatr
 	return
 }
 
-// distanceLineAndLine returns distance Between the two Geometry.
+// distanceLineAndLine returns distance Between the two Geometry,
+// locMatrix is set to the closest points on from and on to, or to the intersection of lines.
 func distanceLineAndLine(from, to matrix.LineMatrix, locMatrix []matrix.Matrix) (dist float64) {
 	dist = math.MaxFloat64
 	loc := []matrix.Matrix{{0, 0}, {0, 0}}
-	if mark := operation.IsIntersectedLineMatrix(from, to); mark {
+	if mark, ips := operation.FindIntersectionLineMatrix(from, to); mark {
+		locMatrix[0], locMatrix[1] = ips[0].Matrix, ips[0].Matrix
 		return 0
 	}
 	for _, v := range from {
@@ -209,22 +251,41 @@ func distanceLineAndLine(from, to matrix.LineMatrix, locMatrix []matrix.Matrix)
 	}
 	for _, v := range to {
 		if distP := distanceLineToPoint(from, matrix.Matrix(v), loc); dist > distP {
-			locMatrix[0], locMatrix[1] = loc[0], loc[1]
+			locMatrix[0], locMatrix[1] = loc[1], loc[0]
 			dist = distP
 		}
 	}
 	return dist
 }
 
-// distancePolygonAndLine returns distance Between the two Geometry.
+// distancePolygonAndLine returns distance Between the two Geometry,
+// locMatrix is set to the closest points on line and on polygon.
 func distancePolygonAndLine(poly matrix.PolygonMatrix, line matrix.LineMatrix, locMatrix []matrix.Matrix) (dist float64) {
+	// the line is inside the polygon if its first point is, unless it crosses the rings.
+	if len(line) > 0 && inPolygon(poly, line[0]) {
+		locMatrix[0], locMatrix[1] = line[0], line[0]
+		return 0
+	}
 	dist = math.MaxFloat64
 	loc := []matrix.Matrix{{0, 0}, {0, 0}}
 	for _, v := range poly {
-		if distP := distanceLineAndLine(matrix.LineMatrix(v), line, loc); dist > distP {
+		if distP := distanceLineAndLine(line, matrix.LineMatrix(v), loc); dist > distP {
 			locMatrix[0], locMatrix[1] = loc[0], loc[1]
 			dist = distP
 		}
 	}
 	return dist
 }
+
+// inPolygon returns true if the point is inside the shell of polygon and outside its holes.
+func inPolygon(poly matrix.PolygonMatrix, pt matrix.Matrix) bool {
+	if len(poly) == 0 || !operation.IsPnPolygon(pt, poly[0]) {
+		return false
+	}
+	for _, hole := range poly[1:] {
+		if operation.IsPnPolygon(pt, hole) {
+			return false
+		}
+	}
+	return true
+}
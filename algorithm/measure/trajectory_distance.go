@@ -0,0 +1,139 @@
+package measure
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// FrechetDistance An algorithm for computing the discrete Fréchet distance,
+// which is the shortest leash for walking the vertices of both geometries forward in order,
+// so unlike the Hausdorff distance it depends on the order of the vertices.
+type FrechetDistance struct {
+}
+
+// Distance returns the discrete Fréchet distance of the vertices of geometries.
+func (f *FrechetDistance) Distance(g0, g1 matrix.Steric) float64 {
+	return discreteFrechet(matrix.TransMatrixes(g0), matrix.TransMatrixes(g1))
+}
+
+// DistanceDensifyFrac returns the discrete Fréchet distance with the segments of geometries densified,
+// each segment is divided into the parts of the fraction of its length, which approaches the continuous Fréchet distance.
+func (f *FrechetDistance) DistanceDensifyFrac(g0, g1 matrix.Steric, densifyFrac float64) (float64, error) {
+	if densifyFrac > 1.0 || densifyFrac <= 0.0 {
+		return 0, algorithm.ErrWrongFractionRange
+	}
+	numSubSegs := int(1.0 / densifyFrac)
+	return discreteFrechet(densifyPoints(matrix.TransMatrixes(g0), numSubSegs),
+		densifyPoints(matrix.TransMatrixes(g1), numSubSegs)), nil
+}
+
+// DynamicTimeWarping returns the dynamic time warping distance of the vertices of geometries,
+// which is the smallest sum of the distances of the vertices matched in order,
+// each vertex is matched to one vertex of the other geometry at least.
+func DynamicTimeWarping(g0, g1 matrix.Steric) float64 {
+	p, q := matrix.TransMatrixes(g0), matrix.TransMatrixes(g1)
+	if len(p) == 0 || len(q) == 0 {
+		return 0
+	}
+	prev, curr := make([]float64, len(q)), make([]float64, len(q))
+	for i := range p {
+		for j := range q {
+			d := pointDistance(p[i], q[j])
+			switch {
+			case i == 0 && j == 0:
+				curr[j] = d
+			case i == 0:
+				curr[j] = d + curr[j-1]
+			case j == 0:
+				curr[j] = d + prev[j]
+			default:
+				curr[j] = d + math.Min(prev[j-1], math.Min(prev[j], curr[j-1]))
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(q)-1]
+}
+
+// LCSSSimilarity returns the similarity of the longest common subsequence of the vertices of geometries,
+// two vertices match if their distance is not larger than epsilon and their indexes differ by delta at most,
+// the indexes are not limited if delta is negative.
+// The similarity is the length of subsequence divided by the number of vertices of the shorter geometry,
+// it is in the range [0, 1] and 1 means the shorter one matches the other entirely.
+func LCSSSimilarity(g0, g1 matrix.Steric, epsilon float64, delta int) float64 {
+	p, q := matrix.TransMatrixes(g0), matrix.TransMatrixes(g1)
+	if len(p) == 0 || len(q) == 0 {
+		return 0
+	}
+	prev, curr := make([]int, len(q)+1), make([]int, len(q)+1)
+	for i := range p {
+		for j := range q {
+			if (delta < 0 || abs(i-j) <= delta) && pointDistance(p[i], q[j]) <= epsilon {
+				curr[j+1] = prev[j] + 1
+			} else if prev[j+1] > curr[j] {
+				curr[j+1] = prev[j+1]
+			} else {
+				curr[j+1] = curr[j]
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return float64(prev[len(q)]) / math.Min(float64(len(p)), float64(len(q)))
+}
+
+// discreteFrechet returns the discrete Fréchet distance of the sequences of points.
+func discreteFrechet(p, q []matrix.Matrix) float64 {
+	if len(p) == 0 || len(q) == 0 {
+		return 0
+	}
+	prev, curr := make([]float64, len(q)), make([]float64, len(q))
+	for i := range p {
+		for j := range q {
+			d := pointDistance(p[i], q[j])
+			switch {
+			case i == 0 && j == 0:
+				curr[j] = d
+			case i == 0:
+				curr[j] = math.Max(d, curr[j-1])
+			case j == 0:
+				curr[j] = math.Max(d, prev[j])
+			default:
+				curr[j] = math.Max(d, math.Min(prev[j-1], math.Min(prev[j], curr[j-1])))
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(q)-1]
+}
+
+// densifyPoints returns the points with each segment divided into the parts.
+func densifyPoints(pts []matrix.Matrix, numSubSegs int) []matrix.Matrix {
+	if len(pts) < 2 || numSubSegs < 2 {
+		return pts
+	}
+	result := []matrix.Matrix{pts[0]}
+	for i := 1; i < len(pts); i++ {
+		p0, p1 := pts[i-1], pts[i]
+		for k := 1; k < numSubSegs; k++ {
+			f := float64(k) / float64(numSubSegs)
+			result = append(result, matrix.Matrix{p0[0] + f*(p1[0]-p0[0]), p0[1] + f*(p1[1]-p0[1])})
+		}
+		result = append(result, p1)
+	}
+	return result
+}
+
+// pointDistance returns the distance of the points.
+func pointDistance(p, q matrix.Matrix) float64 {
+	return math.Hypot(p[0]-q[0], p[1]-q[1])
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,90 @@
+package measure
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestFrechetDistance_Distance(t *testing.T) {
+	tests := []struct {
+		name   string
+		g0, g1 matrix.Steric
+		want   float64
+	}{
+		{"parallel", matrix.LineMatrix{{0, 0}, {1, 0}, {2, 0}}, matrix.LineMatrix{{0, 1}, {1, 1}, {2, 1}}, 1},
+		// the Hausdorff distance is 0 for the reversed line, but the Fréchet distance is not.
+		{"reversed", matrix.LineMatrix{{0, 0}, {2, 0}}, matrix.LineMatrix{{2, 0}, {0, 0}}, 2},
+		{"different vertices", matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.LineMatrix{{0, 0}, {5, 1}, {10, 0}}, math.Sqrt(26)},
+		{"empty", matrix.LineMatrix{}, matrix.LineMatrix{{0, 0}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (&FrechetDistance{}).Distance(tt.g0, tt.g1); math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("Distance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrechetDistance_DistanceDensifyFrac(t *testing.T) {
+	g0, g1 := matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.LineMatrix{{0, 0}, {5, 1}, {10, 0}}
+	got, err := (&FrechetDistance{}).DistanceDensifyFrac(g0, g1, 0.01)
+	if err != nil {
+		t.Fatalf("DistanceDensifyFrac() error = %v", err)
+	}
+	// the continuous Fréchet distance is 1 at the vertex (5, 1).
+	if math.Abs(got-1) > 0.01 {
+		t.Errorf("DistanceDensifyFrac() = %v, want %v", got, 1)
+	}
+	if _, err := (&FrechetDistance{}).DistanceDensifyFrac(g0, g1, 0); err != algorithm.ErrWrongFractionRange {
+		t.Errorf("DistanceDensifyFrac() error = %v, want %v", err, algorithm.ErrWrongFractionRange)
+	}
+}
+
+func TestDynamicTimeWarping(t *testing.T) {
+	tests := []struct {
+		name   string
+		g0, g1 matrix.Steric
+		want   float64
+	}{
+		{"same", matrix.LineMatrix{{0, 0}, {1, 0}, {2, 0}}, matrix.LineMatrix{{0, 0}, {1, 0}, {2, 0}}, 0},
+		{"repeated vertex", matrix.LineMatrix{{0, 0}, {1, 0}, {2, 0}}, matrix.LineMatrix{{0, 0}, {0, 0}, {1, 0}, {2, 0}}, 0},
+		{"shifted", matrix.LineMatrix{{0, 0}, {1, 0}, {2, 0}}, matrix.LineMatrix{{0, 1}, {1, 1}, {2, 1}}, 3},
+		{"reversed", matrix.LineMatrix{{0, 0}, {2, 0}}, matrix.LineMatrix{{2, 0}, {0, 0}}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DynamicTimeWarping(tt.g0, tt.g1); got != tt.want {
+				t.Errorf("DynamicTimeWarping() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLCSSSimilarity(t *testing.T) {
+	track := matrix.LineMatrix{{0, 0}, {1, 0}, {2, 0}, {3, 0}}
+	tests := []struct {
+		name    string
+		g1      matrix.Steric
+		epsilon float64
+		delta   int
+		want    float64
+	}{
+		{"same", track, 0, -1, 1},
+		{"noisy", matrix.LineMatrix{{0, 0.1}, {1, -0.1}, {2, 5}, {3, 0.1}}, 0.2, -1, 0.75},
+		{"shorter", matrix.LineMatrix{{2, 0}, {3, 0}}, 0, -1, 1},
+		{"in window", matrix.LineMatrix{{2, 0}, {3, 0}}, 0, 2, 1},
+		{"out of window", matrix.LineMatrix{{2, 0}, {3, 0}}, 0, 1, 0},
+		{"reversed", matrix.LineMatrix{{3, 0}, {2, 0}, {1, 0}, {0, 0}}, 0, -1, 0.25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LCSSSimilarity(track, tt.g1, tt.epsilon, tt.delta); got != tt.want {
+				t.Errorf("LCSSSimilarity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package operation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/index"
+	"github.com/spatial-go/geoos/index/intervalrtree"
+)
+
+// areaLocator finds the polygons whose areas contain the point by the MakeValid method,
+// the polygons are found by the x intervals of their envelopes and their rings are located by IndexedPointInAreaLocator.
+type areaLocator struct {
+	tree *intervalrtree.SortedPackedIntervalRTree
+}
+
+// polygonLocator locates the point in the rings of polygon, the rings are located together for MakeValidLinework
+// and one by one for MakeValidStructure.
+type polygonLocator struct {
+	index    int
+	bounds   []matrix.Bound
+	locators []*IndexedPointInAreaLocator
+}
+
+// newAreaLocator returns the locator of the polygons by the method.
+func newAreaLocator(polys []matrix.PolygonMatrix, method int) *areaLocator {
+	a := &areaLocator{tree: &intervalrtree.SortedPackedIntervalRTree{}}
+	for i, poly := range polys {
+		if len(poly) == 0 || len(poly[0]) == 0 {
+			continue
+		}
+		l, bound := &polygonLocator{index: i}, polygonBound(poly)
+		if method == MakeValidStructure {
+			for _, ring := range poly {
+				l.bounds = append(l.bounds, matrix.LineMatrix(ring).Bound())
+				l.locators = append(l.locators, NewIndexedPointInAreaLocator(matrix.PolygonMatrix{ring}))
+			}
+		} else {
+			l.bounds = append(l.bounds, bound)
+			l.locators = append(l.locators, NewIndexedPointInAreaLocator(poly))
+		}
+		// the index is not queried yet, so the polygon is inserted.
+		_ = a.tree.Insert(envelope.TwoMatrix(bound[0], bound[1]), l)
+	}
+	return a
+}
+
+// locate returns the indexes of the polygons whose areas contain the point, in ascending order.
+func (a *areaLocator) locate(p matrix.Matrix) []int {
+	visitor := &index.ArrayVisitor{}
+	_ = a.tree.QueryVisitor(envelope.TwoMatrix(p, p), visitor)
+	indexes := []int{}
+	for _, item := range visitor.ItemsArray {
+		if l := item.(*polygonLocator); l.contains(p) {
+			indexes = append(indexes, l.index)
+		}
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// contains returns true if the point is in the interior of the first ring and not in the interior of the others,
+// the first ring is the whole polygon for MakeValidLinework.
+func (l *polygonLocator) contains(p matrix.Matrix) bool {
+	if !l.bounds[0].Contains(p) || l.locators[0].Locate(p) != calc.ImInterior {
+		return false
+	}
+	for i := 1; i < len(l.locators); i++ {
+		if l.bounds[i].Contains(p) && l.locators[i].Locate(p) == calc.ImInterior {
+			return false
+		}
+	}
+	return true
+}
+
+// polygonBound returns the bound of all the rings of polygon.
+func polygonBound(poly matrix.PolygonMatrix) matrix.Bound {
+	bound := matrix.Bound{{math.MaxFloat64, math.MaxFloat64}, {-math.MaxFloat64, -math.MaxFloat64}}
+	for _, ring := range poly {
+		for _, v := range ring {
+			bound[0][0], bound[0][1] = math.Min(bound[0][0], v[0]), math.Min(bound[0][1], v[1])
+			bound[1][0], bound[1][1] = math.Max(bound[1][0], v[0]), math.Max(bound[1][1], v[1])
+		}
+	}
+	return bound
+}
+
+// edgeIndex indexes the noded edges by the x intervals of their envelopes.
+type edgeIndex struct {
+	lines []matrix.LineMatrix
+	tree  *intervalrtree.SortedPackedIntervalRTree
+}
+
+// newEdgeIndex returns the index of the two points lines of edges.
+func newEdgeIndex(lines []matrix.LineMatrix) *edgeIndex {
+	x := &edgeIndex{lines: lines, tree: &intervalrtree.SortedPackedIntervalRTree{}}
+	for i, line := range lines {
+		// the index is not queried yet, so the edge is inserted.
+		_ = x.tree.Insert(envelope.TwoMatrix(line[0], line[1]), i)
+	}
+	return x
+}
+
+// sidePoints returns the points on the left and the right of the middle of edge i.
+// The points are closer to the edge than any other edge, so they are in the faces beside the edge,
+// only the edges near the middle are checked.
+func (x *edgeIndex) sidePoints(i int) (left, right matrix.Matrix) {
+	p0, p1 := x.lines[i][0], x.lines[i][1]
+	dx, dy := p1[0]-p0[0], p1[1]-p0[1]
+	length := math.Hypot(dx, dy)
+	mid := matrix.Matrix{(p0[0] + p1[0]) / 2, (p0[1] + p1[1]) / 2}
+	offset := length / 4
+
+	// the edges farther than the half of length don't shorten the offset.
+	near := envelope.FourFloat(mid[0]-length/2, mid[0]+length/2, mid[1]-length/2, mid[1]+length/2)
+	visitor := &index.ArrayVisitor{}
+	_ = x.tree.QueryVisitor(near, visitor)
+	for _, item := range visitor.ItemsArray {
+		j := item.(int)
+		if i != j && envelope.TwoMatrix(x.lines[j][0], x.lines[j][1]).IsIntersects(near) {
+			offset = math.Min(offset, distanceToSegment(mid, x.lines[j][0], x.lines[j][1])/2)
+		}
+	}
+	nx, ny := -dy/length*offset, dx/length*offset
+	return matrix.Matrix{mid[0] + nx, mid[1] + ny}, matrix.Matrix{mid[0] - nx, mid[1] - ny}
+}
@@ -27,10 +27,12 @@ func FindIntersection(aStart, aEnd, bStart, bEnd matrix.Matrix) (mark bool, ips
 	v := matrix.Matrix{bEnd[0] - bStart[0], bEnd[1] - bStart[1]}
 
 	determinant := CrossProduct(aStart, aEnd, bStart, bEnd)
+	// the sign of cross product is exact, so the segments exactly parallel are never intersected as crossing.
+	orient := calc.CrossProductSign(aStart[0], aStart[1], aEnd[0], aEnd[1], bStart[0], bStart[1], bEnd[0], bEnd[1])
 
 	accuracy := calc.DefaultTolerance * math.Max((aStart[0]+aEnd[0])/2.0, (aStart[1]+aEnd[1])/2.0)
 
-	if math.Abs(determinant) < accuracy {
+	if orient == calc.Collinear || determinant == 0 || math.Abs(determinant) < accuracy {
 		isEnter := true
 		if (u[0] > 0 && v[0] > 0) || (u[1] > 0 && v[1] > 0) {
 			isEnter = false
@@ -103,7 +105,7 @@ func FindIntersection(aStart, aEnd, bStart, bEnd matrix.Matrix) (mark bool, ips
 				ip = bEnd
 				isOriginal = true
 			}
-			ips = append(ips, Intersection{ip, isIntersectionPoint, determinant < 0, isOriginal, false})
+			ips = append(ips, Intersection{ip, isIntersectionPoint, orient == calc.ClockWise, isOriginal, false})
 
 			mark = true
 		} else {
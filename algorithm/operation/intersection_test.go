@@ -0,0 +1,39 @@
+package operation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestFindIntersection(t *testing.T) {
+	tests := []struct {
+		name                       string
+		aStart, aEnd, bStart, bEnd matrix.Matrix
+		want                       bool
+		wantNums                   int
+	}{
+		{"crossing", matrix.Matrix{0, 0}, matrix.Matrix{2, 2}, matrix.Matrix{0, 2}, matrix.Matrix{2, 0}, true, 1},
+		{"disjoint", matrix.Matrix{0, 0}, matrix.Matrix{1, 1}, matrix.Matrix{0, 2}, matrix.Matrix{0.5, 1.5}, false, 0},
+		{"parallel", matrix.Matrix{0, 0}, matrix.Matrix{2, 2}, matrix.Matrix{0, 1}, matrix.Matrix{2, 3}, false, 0},
+		{"collinear overlapping", matrix.Matrix{0, 0}, matrix.Matrix{2, 2}, matrix.Matrix{1, 1}, matrix.Matrix{3, 3}, true, 2},
+		{"collinear overlapping negative", matrix.Matrix{-10, -10}, matrix.Matrix{-5, -5},
+			matrix.Matrix{-7, -7}, matrix.Matrix{-2, -2}, true, 2},
+		{"parallel negative", matrix.Matrix{-10, -10}, matrix.Matrix{-5, -5},
+			matrix.Matrix{-10, -9}, matrix.Matrix{-5, -4}, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ips := FindIntersection(tt.aStart, tt.aEnd, tt.bStart, tt.bEnd)
+			if got != tt.want || len(ips) != tt.wantNums {
+				t.Errorf("FindIntersection() = %v, %v, want %v, %v intersections", got, ips, tt.want, tt.wantNums)
+			}
+			for _, ip := range ips {
+				if math.IsNaN(ip.Matrix[0]) || math.IsNaN(ip.Matrix[1]) {
+					t.Errorf("FindIntersection() intersection = %v", ip.Matrix)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package operation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// const MakeValid methods.
+const (
+	// MakeValidLinework nodes the linework of all the rings and builds the polygons from it,
+	// the areas enclosed by an odd number of rings of a polygon are kept,
+	// so the bow-tie is split into two polygons and the hole outside the shell becomes a polygon.
+	MakeValidLinework = iota
+
+	// MakeValidStructure keeps the area of the shells and removes the area of the holes
+	// in the manner of buffer(0), so the hole outside the shell is discarded.
+	// The lobes of self-intersecting shells are all kept.
+	MakeValidStructure
+)
+
+// MakeValidPolygon returns the valid polygon or multi polygon which covers the same area as the input,
+// the input is a polygon or a collection of polygons that is treated as a multi polygon,
+// the overlapping polygons are merged.
+// The self-intersections are noded, the repeated points and the spikes are removed,
+// the rings collapsed to lines or points are discarded and the empty polygon is returned if nothing remains.
+// The shells of result are counter-clockwise and the holes are clockwise.
+func MakeValidPolygon(m matrix.Steric, method int) matrix.Steric {
+	polys := []matrix.PolygonMatrix{}
+	switch mm := m.(type) {
+	case matrix.PolygonMatrix:
+		polys = append(polys, mm)
+	case matrix.MultiPolygonMatrix:
+		for _, v := range mm {
+			polys = append(polys, v)
+		}
+	case matrix.Collection:
+		for _, v := range mm {
+			if p, ok := v.(matrix.PolygonMatrix); ok {
+				polys = append(polys, p)
+			}
+		}
+	}
+
+	lines := []matrix.LineMatrix{}
+	for _, p := range polys {
+		for _, r := range p {
+			ring := matrix.LineMatrix(r)
+			if len(ring) > 0 && !ring.IsClosed() {
+				ring = append(append(matrix.LineMatrix{}, ring...), ring[0])
+			}
+			lines = append(lines, ring)
+		}
+	}
+	noded := NodeLines(lines)
+	locator := newAreaLocator(polys, method)
+	inside := func(p matrix.Matrix) bool {
+		return len(locator.locate(p)) > 0
+	}
+
+	shells, holes := buildAreaRings(noded, boundaryEdges(noded, inside))
+	return assemblePolygons(shells, holes)
+}
+
+// PolygonsFromDirectedEdges builds the polygons from the edges which are directed with the area on their left,
+// the edges are the indexes of nodes and must intersect only at the nodes.
+// The rings touching themselves are split at the touching nodes,
+// the result is the same as MakeValidPolygon.
+func PolygonsFromDirectedEdges(nodes []matrix.Matrix, edges [][2]int) matrix.Steric {
+	shells, holes := buildAreaRings(&NodedEdges{Nodes: nodes, Edges: edges}, edges)
+	return assemblePolygons(shells, holes)
+}
+
+// boundaryEdges returns the edges that separate the area from the exterior,
+// the edges are directed with the area on their left.
+func boundaryEdges(noded *NodedEdges, inside func(p matrix.Matrix) bool) [][2]int {
+	edges := newEdgeIndex(noded.Lines())
+	result := [][2]int{}
+	for i, e := range noded.Edges {
+		leftPoint, rightPoint := edges.sidePoints(i)
+		left, right := inside(leftPoint), inside(rightPoint)
+		switch {
+		case left && !right:
+			result = append(result, e)
+		case right && !left:
+			result = append(result, [2]int{e[1], e[0]})
+		}
+	}
+	return result
+}
+
+// buildAreaRings links the directed edges into the rings that do not touch themselves,
+// the shells are counter-clockwise and the holes are clockwise.
+func buildAreaRings(noded *NodedEdges, edges [][2]int) (shells, holes []matrix.LineMatrix) {
+	outgoing := map[int][]int{}
+	for i, e := range edges {
+		outgoing[e[0]] = append(outgoing[e[0]], i)
+	}
+	angle := func(from, to int) float64 {
+		p0, p1 := noded.Nodes[from], noded.Nodes[to]
+		return math.Atan2(p1[1]-p0[1], p1[0]-p0[0])
+	}
+
+	used := make([]bool, len(edges))
+	for start := range edges {
+		if used[start] {
+			continue
+		}
+		path := []int{edges[start][0]}
+		for e := start; !used[e]; {
+			used[e] = true
+			from, to := edges[e][0], edges[e][1]
+			path = append(path, to)
+
+			// the next edge is the first one clockwise from the reverse of the current edge,
+			// which keeps the area on the left.
+			back := angle(to, from)
+			next, best := -1, math.Inf(1)
+			for _, candidate := range outgoing[to] {
+				if used[candidate] {
+					continue
+				}
+				turn := back - angle(to, edges[candidate][1])
+				if turn <= 0 {
+					turn += 2 * math.Pi
+				}
+				if turn < best {
+					next, best = candidate, turn
+				}
+			}
+			if next < 0 {
+				break
+			}
+			e = next
+		}
+		for _, ring := range splitRing(path) {
+			coords := ringCoordinates(noded.Nodes, ring)
+			switch area := signedArea(coords); {
+			case area > 0:
+				shells = append(shells, coords)
+			case area < 0:
+				holes = append(holes, coords)
+			}
+		}
+	}
+	return
+}
+
+// ringCoordinates returns the coordinates of the closed ring of nodes,
+// the ring starts from the lowest point, so the result does not depend on the order of edges.
+func ringCoordinates(nodes []matrix.Matrix, ring []int) matrix.LineMatrix {
+	first := 0
+	for i, index := range ring[:len(ring)-1] {
+		if compareMatrix(nodes[index], nodes[ring[first]]) < 0 {
+			first = i
+		}
+	}
+	coords := make(matrix.LineMatrix, 0, len(ring))
+	for i := 0; i < len(ring); i++ {
+		coords = append(coords, nodes[ring[(first+i)%(len(ring)-1)]])
+	}
+	return coords
+}
+
+// splitRing splits the closed path of nodes into the rings at the nodes it visits more than once.
+func splitRing(path []int) [][]int {
+	rings := [][]int{}
+	stack := []int{}
+	position := map[int]int{}
+	for _, node := range path {
+		if pos, ok := position[node]; ok {
+			ring := append(append([]int{}, stack[pos:]...), node)
+			if len(ring) > 3 {
+				rings = append(rings, ring)
+			}
+			for _, v := range stack[pos+1:] {
+				delete(position, v)
+			}
+			stack = stack[:pos+1]
+			continue
+		}
+		position[node] = len(stack)
+		stack = append(stack, node)
+	}
+	return rings
+}
+
+// assemblePolygons assigns each hole to the smallest shell containing it.
+func assemblePolygons(shells, holes []matrix.LineMatrix) matrix.Steric {
+	polys := make([]matrix.PolygonMatrix, len(shells))
+	areas := make([]float64, len(shells))
+	for i, shell := range shells {
+		polys[i] = matrix.PolygonMatrix{shell}
+		areas[i] = signedArea(shell)
+	}
+	for _, hole := range holes {
+		mid := matrix.Matrix{(hole[0][0] + hole[1][0]) / 2, (hole[0][1] + hole[1][1]) / 2}
+		owner := -1
+		for i, shell := range shells {
+			if (owner < 0 || areas[i] < areas[owner]) && IsPnPolygon(mid, shell) {
+				owner = i
+			}
+		}
+		if owner >= 0 {
+			polys[owner] = append(polys[owner], hole)
+		}
+	}
+	sort.SliceStable(polys, func(i, j int) bool {
+		return compareMatrix(polys[i][0][0], polys[j][0][0]) < 0
+	})
+
+	switch len(polys) {
+	case 0:
+		return matrix.PolygonMatrix{}
+	case 1:
+		return polys[0]
+	default:
+		coll := matrix.Collection{}
+		for _, p := range polys {
+			coll = append(coll, p)
+		}
+		return coll
+	}
+}
+
+// signedArea returns the area of ring, it is positive if the ring is counter-clockwise.
+func signedArea(ring matrix.LineMatrix) float64 {
+	sum := 0.0
+	for i := 0; i < len(ring)-1; i++ {
+		sum += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+	}
+	return sum / 2
+}
+
+// distanceToSegment returns the distance from p to the segment ab.
+func distanceToSegment(p, a, b matrix.Matrix) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	lengthSquare := dx*dx + dy*dy
+	if lengthSquare == 0 {
+		return math.Hypot(p[0]-a[0], p[1]-a[1])
+	}
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / lengthSquare
+	t = math.Max(0, math.Min(1, t))
+	return math.Hypot(p[0]-a[0]-t*dx, p[1]-a[1]-t*dy)
+}
+
+// compareMatrix compares the points by x then y.
+func compareMatrix(a, b []float64) int {
+	switch {
+	case a[0] < b[0]:
+		return -1
+	case a[0] > b[0]:
+		return 1
+	case a[1] < b[1]:
+		return -1
+	case a[1] > b[1]:
+		return 1
+	}
+	return 0
+}
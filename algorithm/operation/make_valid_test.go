@@ -0,0 +1,80 @@
+package operation
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestMakeValidPolygon(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}
+	tests := []struct {
+		name   string
+		m      matrix.Steric
+		method int
+		want   matrix.Steric
+	}{
+		{name: "valid polygon", m: square, method: MakeValidLinework, want: square},
+		{name: "bow-tie", m: matrix.PolygonMatrix{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}}, method: MakeValidLinework,
+			want: matrix.Collection{
+				matrix.PolygonMatrix{{{0, 0}, {1, 1}, {0, 2}, {0, 0}}},
+				matrix.PolygonMatrix{{{1, 1}, {2, 0}, {2, 2}, {1, 1}}},
+			}},
+		{name: "clockwise shell and counter-clockwise hole",
+			m:      matrix.PolygonMatrix{{{0, 0}, {0, 4}, {4, 4}, {4, 0}, {0, 0}}, {{1, 1}, {2, 1}, {2, 2}, {1, 1}}},
+			method: MakeValidLinework,
+			want:   matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}, {{1, 1}, {2, 2}, {2, 1}, {1, 1}}}},
+		{name: "hole outside shell linework",
+			m:      matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}, {{5, 5}, {6, 5}, {6, 6}, {5, 5}}},
+			method: MakeValidLinework,
+			want: matrix.Collection{
+				square,
+				matrix.PolygonMatrix{{{5, 5}, {6, 5}, {6, 6}, {5, 5}}},
+			}},
+		{name: "hole outside shell structure",
+			m:      matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}, {{5, 5}, {6, 5}, {6, 6}, {5, 5}}},
+			method: MakeValidStructure,
+			want:   square},
+		{name: "hole touches shell",
+			m:      matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}, {{2, 0}, {3, 1}, {1, 1}, {2, 0}}},
+			method: MakeValidLinework,
+			want:   matrix.PolygonMatrix{{{0, 0}, {2, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}, {{1, 1}, {3, 1}, {2, 0}, {1, 1}}}},
+		{name: "overlapping parts", m: matrix.Collection{
+			matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}},
+			matrix.PolygonMatrix{{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}}},
+		}, method: MakeValidLinework,
+			want: matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 2}, {0, 2}, {0, 0}}}},
+		{name: "repeated point and spike",
+			m:      matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 0}, {4, 4}, {6, 6}, {4, 4}, {0, 4}, {0, 0}}},
+			method: MakeValidLinework,
+			want:   square},
+		{name: "collapsed", m: matrix.PolygonMatrix{{{0, 0}, {1, 1}, {2, 2}, {0, 0}}},
+			method: MakeValidLinework, want: matrix.PolygonMatrix{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MakeValidPolygon(tt.m, tt.method); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MakeValidPolygon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeValidPolygon_ManyVertices(t *testing.T) {
+	// the valid ring of many vertices is kept, the side points of edges are found by the index.
+	ring := matrix.LineMatrix{}
+	for i := 0; i < 20000; i++ {
+		a := 2 * math.Pi * float64(i) / 20000
+		r := 10 + math.Sin(7*a)
+		ring = append(ring, []float64{r * math.Cos(a), r * math.Sin(a)})
+	}
+	ring = append(ring, ring[0])
+	for _, method := range []int{MakeValidLinework, MakeValidStructure} {
+		got, ok := MakeValidPolygon(matrix.PolygonMatrix{ring}, method).(matrix.PolygonMatrix)
+		if !ok || len(got) != 1 || len(got[0]) != len(ring) || math.Abs(signedArea(got[0])-signedArea(ring)) > 1e-9 {
+			t.Errorf("MakeValidPolygon() of %v vertices = %v rings, want the ring", len(ring), len(got))
+		}
+	}
+}
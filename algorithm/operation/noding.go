@@ -0,0 +1,205 @@
+package operation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// NodedEdges is the result of noding, the edges intersect only at the nodes.
+type NodedEdges struct {
+	Nodes []matrix.Matrix
+	// Edges are the indexes of the start node and the end node of edges.
+	Edges [][2]int
+}
+
+// Lines returns the edges as two points lines.
+func (n *NodedEdges) Lines() []matrix.LineMatrix {
+	lines := make([]matrix.LineMatrix, 0, len(n.Edges))
+	for _, e := range n.Edges {
+		lines = append(lines, matrix.LineMatrix{n.Nodes[e[0]], n.Nodes[e[1]]})
+	}
+	return lines
+}
+
+// NodeLines computes the full noding of the lines.
+// The lines are split at all the intersections and the vertices of other lines on them,
+// the points closer than tolerance are snapped together and the repeated edges are merged,
+// so the result edges intersect only at their end points.
+func NodeLines(lines []matrix.LineMatrix) *NodedEdges {
+	n := newNoder(lines)
+	n.computeIntersections()
+	return n.edges()
+}
+
+// nodeSegment is a segment of input lines with the points splitting it.
+type nodeSegment struct {
+	p0, p1 matrix.Matrix
+	bound  matrix.Bound
+	splits []matrix.Matrix
+}
+
+// noder computes the noded edges of lines.
+type noder struct {
+	segments  []*nodeSegment
+	tolerance float64
+	cellSize  float64
+	nodes     []matrix.Matrix
+	grid      map[[2]int64][]int
+}
+
+func newNoder(lines []matrix.LineMatrix) *noder {
+	n := &noder{grid: map[[2]int64][]int{}}
+	scale := 1.0
+	for _, line := range lines {
+		for _, v := range line {
+			scale = math.Max(scale, math.Max(math.Abs(v[0]), math.Abs(v[1])))
+		}
+	}
+	n.tolerance = calc.DefaultTolerance * scale
+	n.cellSize = n.tolerance * 16
+	for _, line := range lines {
+		for i := 0; i < len(line)-1; i++ {
+			p0, p1 := matrix.Matrix(line[i]), matrix.Matrix(line[i+1])
+			if math.Hypot(p1[0]-p0[0], p1[1]-p0[1]) <= n.tolerance {
+				continue
+			}
+			n.segments = append(n.segments, &nodeSegment{
+				p0: p0, p1: p1,
+				bound: matrix.Bound{
+					{math.Min(p0[0], p1[0]), math.Min(p0[1], p1[1])},
+					{math.Max(p0[0], p1[0]), math.Max(p0[1], p1[1])},
+				},
+			})
+		}
+	}
+	return n
+}
+
+// computeIntersections finds the split points of the segments whose bounds overlap,
+// the segments are swept from left to right.
+func (n *noder) computeIntersections() {
+	order := make([]*nodeSegment, len(n.segments))
+	copy(order, n.segments)
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].bound[0][0] < order[j].bound[0][0]
+	})
+	for i, a := range order {
+		for _, b := range order[i+1:] {
+			if b.bound[0][0] > a.bound[1][0]+n.tolerance {
+				break
+			}
+			if b.bound[0][1] > a.bound[1][1]+n.tolerance || a.bound[0][1] > b.bound[1][1]+n.tolerance {
+				continue
+			}
+			n.intersect(a, b)
+		}
+	}
+}
+
+// intersect adds the intersection points of segments a and b to their split points.
+func (n *noder) intersect(a, b *nodeSegment) {
+	r := matrix.Matrix{a.p1[0] - a.p0[0], a.p1[1] - a.p0[1]}
+	s := matrix.Matrix{b.p1[0] - b.p0[0], b.p1[1] - b.p0[1]}
+	qp := matrix.Matrix{b.p0[0] - a.p0[0], b.p0[1] - a.p0[1]}
+	lenR, lenS := math.Hypot(r[0], r[1]), math.Hypot(s[0], s[1])
+	denom := r[0]*s[1] - r[1]*s[0]
+
+	if math.Abs(denom) > calc.DefaultTolerance12*lenR*lenS {
+		t := (qp[0]*s[1] - qp[1]*s[0]) / denom
+		u := (qp[0]*r[1] - qp[1]*r[0]) / denom
+		tolT, tolU := n.tolerance/lenR, n.tolerance/lenS
+		if t < -tolT || t > 1+tolT || u < -tolU || u > 1+tolU {
+			return
+		}
+		var ip matrix.Matrix
+		switch {
+		case t <= tolT:
+			ip = a.p0
+		case t >= 1-tolT:
+			ip = a.p1
+		case u <= tolU:
+			ip = b.p0
+		case u >= 1-tolU:
+			ip = b.p1
+		default:
+			ip = matrix.Matrix{a.p0[0] + t*r[0], a.p0[1] + t*r[1]}
+		}
+		a.splits = append(a.splits, ip)
+		b.splits = append(b.splits, ip)
+		return
+	}
+
+	// the segments are parallel, the end points on the other segment split it if they are collinear.
+	if math.Abs(qp[0]*r[1]-qp[1]*r[0])/lenR > n.tolerance {
+		return
+	}
+	n.splitCollinear(a, b.p0, b.p1)
+	n.splitCollinear(b, a.p0, a.p1)
+}
+
+// splitCollinear adds the points lying on the collinear segment to its split points.
+func (n *noder) splitCollinear(seg *nodeSegment, points ...matrix.Matrix) {
+	dx, dy := seg.p1[0]-seg.p0[0], seg.p1[1]-seg.p0[1]
+	length := math.Hypot(dx, dy)
+	for _, p := range points {
+		along := ((p[0]-seg.p0[0])*dx + (p[1]-seg.p0[1])*dy) / length
+		if along > n.tolerance && along < length-n.tolerance {
+			seg.splits = append(seg.splits, p)
+		}
+	}
+}
+
+// edges returns the unique edges between the split points of segments.
+func (n *noder) edges() *NodedEdges {
+	result := &NodedEdges{}
+	seen := map[[2]int]bool{}
+	for _, seg := range n.segments {
+		dx, dy := seg.p1[0]-seg.p0[0], seg.p1[1]-seg.p0[1]
+		points := append([]matrix.Matrix{seg.p0, seg.p1}, seg.splits...)
+		sort.SliceStable(points, func(i, j int) bool {
+			return (points[i][0]-seg.p0[0])*dx+(points[i][1]-seg.p0[1])*dy <
+				(points[j][0]-seg.p0[0])*dx+(points[j][1]-seg.p0[1])*dy
+		})
+		prev := n.node(points[0])
+		for _, p := range points[1:] {
+			next := n.node(p)
+			if next == prev {
+				continue
+			}
+			key := [2]int{prev, next}
+			if prev > next {
+				key = [2]int{next, prev}
+			}
+			if !seen[key] {
+				seen[key] = true
+				result.Edges = append(result.Edges, [2]int{prev, next})
+			}
+			prev = next
+		}
+	}
+	result.Nodes = n.nodes
+	return result
+}
+
+// node returns the index of node at the point, the point is snapped to the node within tolerance.
+func (n *noder) node(p matrix.Matrix) int {
+	cx, cy := int64(math.Floor(p[0]/n.cellSize)), int64(math.Floor(p[1]/n.cellSize))
+	for i := cx - 1; i <= cx+1; i++ {
+		for j := cy - 1; j <= cy+1; j++ {
+			for _, index := range n.grid[[2]int64{i, j}] {
+				node := n.nodes[index]
+				if math.Hypot(node[0]-p[0], node[1]-p[1]) <= n.tolerance {
+					return index
+				}
+			}
+		}
+	}
+	n.nodes = append(n.nodes, matrix.Matrix{p[0], p[1]})
+	index := len(n.nodes) - 1
+	key := [2]int64{cx, cy}
+	n.grid[key] = append(n.grid[key], index)
+	return index
+}
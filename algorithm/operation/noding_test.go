@@ -0,0 +1,32 @@
+package operation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestNodeLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []matrix.LineMatrix
+		want  []matrix.LineMatrix
+	}{
+		{name: "cross", lines: []matrix.LineMatrix{{{0, 0}, {2, 2}}, {{0, 2}, {2, 0}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 1}}, {{1, 1}, {2, 2}}, {{0, 2}, {1, 1}}, {{1, 1}, {2, 0}}}},
+		{name: "touch", lines: []matrix.LineMatrix{{{0, 0}, {2, 0}}, {{1, 0}, {1, 1}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}, {{1, 0}, {1, 1}}}},
+		{name: "overlap", lines: []matrix.LineMatrix{{{0, 0}, {2, 0}}, {{3, 0}, {1, 0}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}, {{3, 0}, {2, 0}}}},
+		{name: "repeated point", lines: []matrix.LineMatrix{{{0, 0}, {1, 0}, {1, 0}, {1, 1}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 0}}, {{1, 0}, {1, 1}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NodeLines(tt.lines).Lines(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NodeLines() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -27,7 +27,9 @@ func InLineSegment(spot, a, b matrix.Matrix) (in bool, isVertex bool) {
 
 	// dAB = (spot[0]-a[0])*(a[1]-b[1]) - (a[0]-b[0])*(spot[1]-a[1])
 
-	if math.Abs(dAB) < accuracy &&
+	// the spot exactly collinear is on the line in spite of the accuracy.
+	collinear := math.Abs(dAB) < accuracy || calc.OrientationIndex(a[0], a[1], b[0], b[1], spot[0], spot[1]) == calc.Collinear
+	if collinear &&
 		(spot[0]+accuracy >= math.Min(a[0], b[0]) && spot[0]-accuracy <= math.Max(a[0], b[0])) &&
 		(spot[1]+accuracy >= math.Min(a[1], b[1]) && spot[1]-accuracy <= math.Max(a[1], b[1])) {
 		return true, false
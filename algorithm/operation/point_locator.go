@@ -0,0 +1,93 @@
+package operation
+
+import (
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/index/intervalrtree"
+)
+
+// IndexedPointInAreaLocator Determines the location of points relative to the polygons,
+// the segments of rings are indexed by their y intervals, so a point is located by counting the crossings
+// of the horizontal ray from it with the segments of the intervals containing its y ordinate.
+type IndexedPointInAreaLocator struct {
+	index *intervalrtree.SortedPackedIntervalRTree
+}
+
+// NewIndexedPointInAreaLocator returns the locator of the polygons.
+func NewIndexedPointInAreaLocator(polys ...matrix.PolygonMatrix) *IndexedPointInAreaLocator {
+	l := &IndexedPointInAreaLocator{index: &intervalrtree.SortedPackedIntervalRTree{}}
+	for _, poly := range polys {
+		for _, ring := range poly {
+			for i := 0; i < len(ring)-1; i++ {
+				seg := &matrix.LineSegment{P0: ring[i], P1: ring[i+1]}
+				env := envelope.FourFloat(seg.P0[1], seg.P1[1], 0, 0)
+				// the index is not queried yet, so the segment is inserted.
+				_ = l.index.Insert(env, seg)
+			}
+		}
+	}
+	return l
+}
+
+// Locate returns the location of point, calc.ImInterior, calc.ImBoundary or calc.ImExterior.
+func (l *IndexedPointInAreaLocator) Locate(p matrix.Matrix) int {
+	counter := &rayCrossingCounter{p: p}
+	if err := l.index.QueryVisitor(envelope.FourFloat(p[1], p[1], 0, 0), counter); err != nil {
+		return calc.ImExterior
+	}
+	switch {
+	case counter.onSegment:
+		return calc.ImBoundary
+	case counter.crossings%2 == 1:
+		return calc.ImInterior
+	}
+	return calc.ImExterior
+}
+
+// rayCrossingCounter counts the crossings of the horizontal ray to the right of point with the segments.
+type rayCrossingCounter struct {
+	p         matrix.Matrix
+	crossings int
+	onSegment bool
+}
+
+// VisitItem counts the crossing of segment.
+func (r *rayCrossingCounter) VisitItem(item interface{}) {
+	seg := item.(*matrix.LineSegment)
+	p, p0, p1 := r.p, seg.P0, seg.P1
+	if r.onSegment || (p0[0] < p[0] && p1[0] < p[0]) {
+		return
+	}
+	if p[0] == p1[0] && p[1] == p1[1] {
+		r.onSegment = true
+		return
+	}
+	// the horizontal segment is only the boundary if the point is on it.
+	if p0[1] == p[1] && p1[1] == p[1] {
+		if (p0[0] <= p[0] && p[0] <= p1[0]) || (p1[0] <= p[0] && p[0] <= p0[0]) {
+			r.onSegment = true
+		}
+		return
+	}
+	// the segment crosses the ray if one endpoint is strictly above it and the other one is not,
+	// so the vertex on the ray is counted only once.
+	if (p0[1] > p[1] && p1[1] <= p[1]) || (p1[1] > p[1] && p0[1] <= p[1]) {
+		orient := calc.OrientationIndex(p0[0], p0[1], p1[0], p1[1], p[0], p[1])
+		if orient == 0 {
+			r.onSegment = true
+			return
+		}
+		if p1[1] < p0[1] {
+			orient = -orient
+		}
+		if orient > 0 {
+			r.crossings++
+		}
+	}
+}
+
+// Items returns the number of crossings.
+func (r *rayCrossingCounter) Items() interface{} {
+	return r.crossings
+}
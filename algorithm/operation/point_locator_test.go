@@ -0,0 +1,37 @@
+package operation
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestIndexedPointInAreaLocator_Locate(t *testing.T) {
+	poly := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}}
+	other := matrix.PolygonMatrix{{{20, 0}, {30, 0}, {25, 10}, {20, 0}}}
+	locator := NewIndexedPointInAreaLocator(poly, other)
+	tests := []struct {
+		name string
+		p    matrix.Matrix
+		want int
+	}{
+		{"interior", matrix.Matrix{2, 2}, calc.ImInterior},
+		{"interior at vertex height", matrix.Matrix{2, 4}, calc.ImInterior},
+		{"hole", matrix.Matrix{5, 5}, calc.ImExterior},
+		{"hole boundary", matrix.Matrix{5, 4}, calc.ImBoundary},
+		{"shell vertex", matrix.Matrix{10, 10}, calc.ImBoundary},
+		{"shell edge", matrix.Matrix{0, 5}, calc.ImBoundary},
+		{"exterior", matrix.Matrix{15, 5}, calc.ImExterior},
+		{"other polygon", matrix.Matrix{25, 5}, calc.ImInterior},
+		{"other polygon edge", matrix.Matrix{22.5, 5}, calc.ImBoundary},
+		{"below", matrix.Matrix{5, -1}, calc.ImExterior},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := locator.Locate(tt.p); got != tt.want {
+				t.Errorf("Locate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package operation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// PolygonizeResult is the result of polygonizing the lines.
+type PolygonizeResult struct {
+	// Polygons are the faces enclosed by the lines, the shells are counter-clockwise and the holes are clockwise.
+	Polygons []matrix.PolygonMatrix
+	// Dangles are the edges which have an end point not incident on any other edge.
+	Dangles []matrix.LineMatrix
+	// CutEdges are the edges which are connected at both ends but do not form part of a polygon.
+	CutEdges []matrix.LineMatrix
+	// InvalidRings are the rings which do not form a valid polygon, e.g. the rings collapsed by snapping.
+	InvalidRings []matrix.LineMatrix
+}
+
+// polygonizeFace is a face of the noded lines, the rings are the indexes of nodes.
+type polygonizeFace struct {
+	shell []int
+	holes [][]int
+	area  float64
+}
+
+// Polygonize computes the polygons formed from the lines.
+// The lines are noded at the intersections first, so they need not be correctly noded.
+// The dangles and cut edges are removed, and each face enclosed by the remaining edges becomes a polygon,
+// the holes of a polygon are the outer rings of the faces nested in it.
+func Polygonize(lines []matrix.LineMatrix) *PolygonizeResult {
+	p := newPolygonizer(lines)
+	result := &PolygonizeResult{}
+	for _, face := range p.faces {
+		poly := matrix.PolygonMatrix{ringCoordinates(p.noded.Nodes, face.shell)}
+		for _, hole := range face.holes {
+			poly = append(poly, ringCoordinates(p.noded.Nodes, hole))
+		}
+		result.Polygons = append(result.Polygons, poly)
+	}
+	sort.SliceStable(result.Polygons, func(i, j int) bool {
+		return compareMatrix(result.Polygons[i][0][0], result.Polygons[j][0][0]) < 0
+	})
+	result.Dangles = p.edgeLines(p.dangles)
+	result.CutEdges = p.edgeLines(p.cutEdges)
+	for _, ring := range p.invalidRings {
+		result.InvalidRings = append(result.InvalidRings, ringCoordinates(p.noded.Nodes, ring))
+	}
+	return result
+}
+
+// BuildArea computes the area formed from the lines, the faces of Polygonize are alternately area and hole
+// from the outside in, so the rings nested in a ring become its holes.
+// The adjacent faces are merged, the result is a polygon, a collection of polygons,
+// or the empty polygon if the lines enclose no area.
+func BuildArea(lines []matrix.LineMatrix) matrix.Steric {
+	p := newPolygonizer(lines)
+	shells := p.shellLocator()
+
+	// the depth of face is the number of shells containing it, the faces of even depth are area.
+	count := map[[2]int]int{}
+	for i, face := range p.faces {
+		depth := 0
+		for _, j := range shells.locate(p.leftPoint(face.shell[0], face.shell[1])) {
+			if i != j {
+				depth++
+			}
+		}
+		if depth%2 == 1 {
+			continue
+		}
+		for _, ring := range append([][]int{face.shell}, face.holes...) {
+			for k := 0; k < len(ring)-1; k++ {
+				count[[2]int{ring[k], ring[k+1]}]++
+			}
+		}
+	}
+
+	// the edges between two faces of area cancel each other.
+	edges := [][2]int{}
+	for _, e := range p.noded.Edges {
+		for _, directed := range [][2]int{e, {e[1], e[0]}} {
+			if count[directed] > count[[2]int{directed[1], directed[0]}] {
+				edges = append(edges, directed)
+			}
+		}
+	}
+	return PolygonsFromDirectedEdges(p.noded.Nodes, edges)
+}
+
+// polygonizer builds the faces of noded lines.
+type polygonizer struct {
+	noded        *NodedEdges
+	segments     *edgeIndex
+	edgeIDs      map[[2]int]int
+	dangles      []int
+	cutEdges     []int
+	invalidRings [][]int
+	faces        []*polygonizeFace
+}
+
+func newPolygonizer(lines []matrix.LineMatrix) *polygonizer {
+	p := &polygonizer{noded: NodeLines(lines), edgeIDs: map[[2]int]int{}}
+	p.segments = newEdgeIndex(p.noded.Lines())
+	for i, e := range p.noded.Edges {
+		p.edgeIDs[e] = i
+	}
+	alive := make([]bool, len(p.noded.Edges))
+	for i := range alive {
+		alive[i] = true
+	}
+	p.removeDangles(alive)
+
+	// the cut edges are on the same ring in both directions, the rings are built again without them.
+	for _, ring := range p.edgeRings(alive) {
+		inRing := map[int]bool{}
+		for _, d := range ring {
+			inRing[d] = true
+		}
+		for _, d := range ring {
+			if d%2 == 0 && inRing[d+1] {
+				p.cutEdges = append(p.cutEdges, d/2)
+			}
+		}
+	}
+	for _, e := range p.cutEdges {
+		alive[e] = false
+	}
+	sort.Ints(p.cutEdges)
+
+	holes := [][]int{}
+	for _, ring := range p.edgeRings(alive) {
+		path := []int{p.directedEdge(ring[0])[0]}
+		for _, d := range ring {
+			path = append(path, p.directedEdge(d)[1])
+		}
+		for _, r := range splitRing(path) {
+			switch area := signedArea(ringCoordinates(p.noded.Nodes, r)); {
+			case area > 0:
+				p.faces = append(p.faces, &polygonizeFace{shell: r, area: area})
+			case area < 0:
+				holes = append(holes, r)
+			default:
+				p.invalidRings = append(p.invalidRings, r)
+			}
+		}
+	}
+	p.assignHoles(holes)
+	return p
+}
+
+// removeDangles removes the edges which have an end point of degree one until there is no such edge.
+func (p *polygonizer) removeDangles(alive []bool) {
+	incident := map[int][]int{}
+	degree := map[int]int{}
+	for i, e := range p.noded.Edges {
+		for _, node := range e {
+			incident[node] = append(incident[node], i)
+			degree[node]++
+		}
+	}
+	stack := []int{}
+	for node, d := range degree {
+		if d == 1 {
+			stack = append(stack, node)
+		}
+	}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, i := range incident[node] {
+			if !alive[i] {
+				continue
+			}
+			alive[i] = false
+			p.dangles = append(p.dangles, i)
+			for _, other := range p.noded.Edges[i] {
+				if degree[other]--; degree[other] == 1 {
+					stack = append(stack, other)
+				}
+			}
+		}
+	}
+	sort.Ints(p.dangles)
+}
+
+// directedEdge returns the start node and the end node of directed edge,
+// the directed edge 2i is the edge i and 2i+1 is its reverse.
+func (p *polygonizer) directedEdge(d int) [2]int {
+	e := p.noded.Edges[d/2]
+	if d%2 == 1 {
+		return [2]int{e[1], e[0]}
+	}
+	return e
+}
+
+// edgeRings links the directed edges of alive edges into the rings with the face on their left.
+func (p *polygonizer) edgeRings(alive []bool) [][]int {
+	outgoing := map[int][]int{}
+	for i, ok := range alive {
+		if ok {
+			for _, d := range []int{2 * i, 2*i + 1} {
+				from := p.directedEdge(d)[0]
+				outgoing[from] = append(outgoing[from], d)
+			}
+		}
+	}
+	angle := func(d int) float64 {
+		e := p.directedEdge(d)
+		p0, p1 := p.noded.Nodes[e[0]], p.noded.Nodes[e[1]]
+		return math.Atan2(p1[1]-p0[1], p1[0]-p0[0])
+	}
+
+	rings := [][]int{}
+	used := map[int]bool{}
+	for i, ok := range alive {
+		for _, start := range []int{2 * i, 2*i + 1} {
+			if !ok || used[start] {
+				continue
+			}
+			ring := []int{}
+			for d := start; !used[d]; {
+				used[d] = true
+				ring = append(ring, d)
+				// the next edge is the first one clockwise from the reverse of the current edge.
+				back := angle(d ^ 1)
+				next, best := -1, math.Inf(1)
+				for _, candidate := range outgoing[p.directedEdge(d)[1]] {
+					turn := back - angle(candidate)
+					if turn <= 0 {
+						turn += 2 * math.Pi
+					}
+					if turn < best {
+						next, best = candidate, turn
+					}
+				}
+				if next < 0 {
+					break
+				}
+				d = next
+			}
+			rings = append(rings, ring)
+		}
+	}
+	return rings
+}
+
+// assignHoles assigns each hole to the smallest face containing the point on its left,
+// the holes which are not in any face are the outer rings of the faces.
+func (p *polygonizer) assignHoles(holes [][]int) {
+	shells := p.shellLocator()
+	for _, hole := range holes {
+		var owner *polygonizeFace
+		for _, i := range shells.locate(p.leftPoint(hole[0], hole[1])) {
+			if face := p.faces[i]; owner == nil || face.area < owner.area {
+				owner = face
+			}
+		}
+		if owner != nil {
+			owner.holes = append(owner.holes, hole)
+		}
+	}
+}
+
+// shellLocator returns the locator of the shells of faces, the polygon i is the shell of face i.
+func (p *polygonizer) shellLocator() *areaLocator {
+	shells := make([]matrix.PolygonMatrix, 0, len(p.faces))
+	for _, face := range p.faces {
+		shells = append(shells, matrix.PolygonMatrix{ringCoordinates(p.noded.Nodes, face.shell)})
+	}
+	return newAreaLocator(shells, MakeValidLinework)
+}
+
+// leftPoint returns the point on the left of the edge from node to node.
+func (p *polygonizer) leftPoint(from, to int) matrix.Matrix {
+	if i, ok := p.edgeIDs[[2]int{from, to}]; ok {
+		left, _ := p.segments.sidePoints(i)
+		return left
+	}
+	if i, ok := p.edgeIDs[[2]int{to, from}]; ok {
+		_, right := p.segments.sidePoints(i)
+		return right
+	}
+	return nil
+}
+
+// edgeLines returns the edges as lines, the edges meeting at a node of degree two are merged into one line.
+func (p *polygonizer) edgeLines(edges []int) []matrix.LineMatrix {
+	degree := map[int]int{}
+	for _, e := range p.noded.Edges {
+		degree[e[0]]++
+		degree[e[1]]++
+	}
+	incident := map[int][]int{}
+	for _, i := range edges {
+		for _, node := range p.noded.Edges[i] {
+			incident[node] = append(incident[node], i)
+		}
+	}
+	used := map[int]bool{}
+	// extend returns the nodes following node through the unused edges, until a node of other degree.
+	extend := func(node int) []int {
+		path := []int{}
+		for degree[node] == 2 {
+			next := -1
+			for _, i := range incident[node] {
+				if !used[i] {
+					next = i
+					break
+				}
+			}
+			if next < 0 {
+				break
+			}
+			used[next] = true
+			if e := p.noded.Edges[next]; e[0] == node {
+				node = e[1]
+			} else {
+				node = e[0]
+			}
+			path = append(path, node)
+		}
+		return path
+	}
+
+	var lines []matrix.LineMatrix
+	for _, i := range edges {
+		if used[i] {
+			continue
+		}
+		used[i] = true
+		e := p.noded.Edges[i]
+		path := extend(e[0])
+		for l, r := 0, len(path)-1; l < r; l, r = l+1, r-1 {
+			path[l], path[r] = path[r], path[l]
+		}
+		path = append(append(path, e[0], e[1]), extend(e[1])...)
+		line := make(matrix.LineMatrix, 0, len(path))
+		for _, node := range path {
+			line = append(line, p.noded.Nodes[node])
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
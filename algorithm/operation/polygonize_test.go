@@ -0,0 +1,102 @@
+package operation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestPolygonize(t *testing.T) {
+	tests := []struct {
+		name         string
+		lines        []matrix.LineMatrix
+		wantPolygons []matrix.PolygonMatrix
+		wantDangles  []matrix.LineMatrix
+		wantCutEdges []matrix.LineMatrix
+	}{
+		{"split square",
+			[]matrix.LineMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{5, -1}, {5, 11}}},
+			[]matrix.PolygonMatrix{
+				{{{0, 0}, {5, 0}, {5, 10}, {0, 10}, {0, 0}}},
+				{{{5, 0}, {10, 0}, {10, 10}, {5, 10}, {5, 0}}},
+			},
+			[]matrix.LineMatrix{{{5, -1}, {5, 0}}, {{5, 10}, {5, 11}}}, nil},
+		{"hole and cut edge",
+			[]matrix.LineMatrix{
+				{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}},
+				{{4, 4}, {6, 6}},
+				{{6, 6}, {8, 6}, {8, 8}, {6, 8}, {6, 6}},
+			},
+			[]matrix.PolygonMatrix{
+				{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}, {{6, 6}, {6, 8}, {8, 8}, {8, 6}, {6, 6}}},
+				{{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}},
+				{{{6, 6}, {8, 6}, {8, 8}, {6, 8}, {6, 6}}},
+			},
+			nil, []matrix.LineMatrix{{{4, 4}, {6, 6}}}},
+		{"cut edge and dangle chains",
+			[]matrix.LineMatrix{
+				{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}},
+				{{2, 2}, {3, 2}, {4, 3}},
+				{{4, 3}, {6, 3}, {6, 5}, {4, 5}, {4, 3}},
+				{{6, 5}, {7, 6}, {7, 8}},
+			},
+			[]matrix.PolygonMatrix{
+				{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}},
+				{{{4, 3}, {6, 3}, {6, 5}, {4, 5}, {4, 3}}},
+			},
+			[]matrix.LineMatrix{{{6, 5}, {7, 6}, {7, 8}}}, []matrix.LineMatrix{{{2, 2}, {3, 2}, {4, 3}}}},
+		{"open lines",
+			[]matrix.LineMatrix{{{0, 0}, {10, 0}, {10, 10}}},
+			nil,
+			[]matrix.LineMatrix{{{0, 0}, {10, 0}, {10, 10}}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Polygonize(tt.lines)
+			if !reflect.DeepEqual(got.Polygons, tt.wantPolygons) {
+				t.Errorf("Polygonize() polygons = %v, want %v", got.Polygons, tt.wantPolygons)
+			}
+			if !reflect.DeepEqual(got.Dangles, tt.wantDangles) {
+				t.Errorf("Polygonize() dangles = %v, want %v", got.Dangles, tt.wantDangles)
+			}
+			if !reflect.DeepEqual(got.CutEdges, tt.wantCutEdges) {
+				t.Errorf("Polygonize() cut edges = %v, want %v", got.CutEdges, tt.wantCutEdges)
+			}
+		})
+	}
+}
+
+func TestBuildArea(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []matrix.LineMatrix
+		want  matrix.Steric
+	}{
+		{"hole",
+			[]matrix.LineMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}},
+			matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}}},
+		{"island in hole",
+			[]matrix.LineMatrix{
+				{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}},
+				{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}},
+			},
+			matrix.Collection{
+				matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}}},
+				matrix.PolygonMatrix{{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}},
+			}},
+		{"merged faces",
+			[]matrix.LineMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{5, 0}, {5, 10}}},
+			matrix.PolygonMatrix{{{0, 0}, {5, 0}, {10, 0}, {10, 10}, {5, 10}, {0, 10}, {0, 0}}}},
+		{"no area", []matrix.LineMatrix{{{0, 0}, {10, 0}}}, matrix.PolygonMatrix{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildArea(tt.lines); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BuildArea() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package operation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// SplitPolygon splits the polygon by the lines of blade, the holes of polygon are kept in the parts.
+// The parts are ordered by their lowest point, each part starts from its lowest point.
+// The polygon itself is returned if the blade does not cut it.
+func SplitPolygon(poly matrix.PolygonMatrix, blade []matrix.LineMatrix) []matrix.PolygonMatrix {
+	lines := []matrix.LineMatrix{}
+	for _, ring := range poly {
+		lines = append(lines, ring)
+	}
+	p := newPolygonizer(append(lines, blade...))
+	area := newAreaLocator([]matrix.PolygonMatrix{poly}, MakeValidStructure)
+
+	parts := []matrix.PolygonMatrix{}
+	for _, face := range p.faces {
+		if len(area.locate(p.leftPoint(face.shell[0], face.shell[1]))) == 0 {
+			continue
+		}
+		part := matrix.PolygonMatrix{ringCoordinates(p.noded.Nodes, face.shell)}
+		for _, hole := range face.holes {
+			part = append(part, ringCoordinates(p.noded.Nodes, hole))
+		}
+		parts = append(parts, part)
+	}
+	if len(parts) < 2 {
+		return []matrix.PolygonMatrix{poly}
+	}
+	sort.SliceStable(parts, func(i, j int) bool {
+		return compareMatrix(parts[i][0][0], parts[j][0][0]) < 0
+	})
+	return parts
+}
+
+// SplitLine splits the line at the points and at the intersections with the lines of blade,
+// the parts are in the order along the line.
+// The line itself is returned if the blade does not cut it.
+func SplitLine(line matrix.LineMatrix, points []matrix.Matrix, blade []matrix.LineMatrix) []matrix.LineMatrix {
+	if len(line) < 2 {
+		return []matrix.LineMatrix{line}
+	}
+	// the tolerance is relative to the magnitude of coordinates, as in noding.
+	vertices := append([]matrix.Matrix{}, points...)
+	for _, l := range append([]matrix.LineMatrix{line}, blade...) {
+		for _, v := range l {
+			vertices = append(vertices, v)
+		}
+	}
+	scale := 1.0
+	for _, v := range vertices {
+		scale = math.Max(scale, math.Max(math.Abs(v[0]), math.Abs(v[1])))
+	}
+	tolerance := calc.DefaultTolerance * scale
+
+	// the split positions of segment i, 0 and 1 are the vertices of segment.
+	positions := make([][]float64, len(line)-1)
+	for i := range positions {
+		a, b := line[i], line[i+1]
+		for _, p := range points {
+			if t, ok := pointPosition(p, a, b, tolerance); ok {
+				positions[i] = append(positions[i], t)
+			}
+		}
+		for _, bl := range blade {
+			for j := 0; j < len(bl)-1; j++ {
+				positions[i] = append(positions[i], segmentPositions(a, b, bl[j], bl[j+1], tolerance)...)
+			}
+		}
+		sort.Float64s(positions[i])
+	}
+
+	parts := []matrix.LineMatrix{}
+	current := matrix.LineMatrix{line[0]}
+	split := func(p []float64) {
+		if !matrix.Matrix(current[len(current)-1]).Equals(matrix.Matrix(p)) {
+			current = append(current, p)
+		}
+		if len(current) > 1 {
+			parts = append(parts, current)
+		}
+		current = matrix.LineMatrix{p}
+	}
+	for i, ts := range positions {
+		a, b := line[i], line[i+1]
+		for _, t := range ts {
+			switch {
+			case t <= 0:
+				if i > 0 {
+					split(a)
+				}
+			case t >= 1:
+				if i < len(positions)-1 {
+					split(b)
+				}
+			default:
+				split([]float64{a[0] + t*(b[0]-a[0]), a[1] + t*(b[1]-a[1])})
+			}
+		}
+		if !matrix.Matrix(current[len(current)-1]).Equals(matrix.Matrix(b)) {
+			current = append(current, b)
+		}
+	}
+	if len(current) > 1 {
+		parts = append(parts, current)
+	}
+	if len(parts) == 0 {
+		return []matrix.LineMatrix{line}
+	}
+	return parts
+}
+
+// pointPosition returns the position of point on the segment ab,
+// returns false if the point is not within the tolerance of it.
+func pointPosition(p, a, b matrix.Matrix, tolerance float64) (float64, bool) {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	lengthSquare := dx*dx + dy*dy
+	if lengthSquare == 0 || distanceToSegment(p, a, b) > tolerance {
+		return 0, false
+	}
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / lengthSquare
+	return math.Max(0, math.Min(1, t)), true
+}
+
+// segmentPositions returns the positions of the intersections of segment cd on the segment ab,
+// the end points of the overlap are returned if the segments are collinear.
+func segmentPositions(a, b, c, d matrix.Matrix, tolerance float64) []float64 {
+	positions := []float64{}
+	r := matrix.Matrix{b[0] - a[0], b[1] - a[1]}
+	s := matrix.Matrix{d[0] - c[0], d[1] - c[1]}
+	denom := r[0]*s[1] - r[1]*s[0]
+	if math.Abs(denom) > calc.DefaultTolerance12*math.Hypot(r[0], r[1])*math.Hypot(s[0], s[1]) {
+		qp := matrix.Matrix{c[0] - a[0], c[1] - a[1]}
+		t := (qp[0]*s[1] - qp[1]*s[0]) / denom
+		u := (qp[0]*r[1] - qp[1]*r[0]) / denom
+		if t >= 0 && t <= 1 && u >= 0 && u <= 1 {
+			positions = append(positions, t)
+		}
+		// the end points of blade touching the segment are found with tolerance.
+		for _, p := range []matrix.Matrix{c, d} {
+			if pt, ok := pointPosition(p, a, b, tolerance); ok && (t < 0 || t > 1 || u < 0 || u > 1) {
+				positions = append(positions, pt)
+			}
+		}
+		return positions
+	}
+	for _, p := range []matrix.Matrix{c, d} {
+		if t, ok := pointPosition(p, a, b, tolerance); ok {
+			positions = append(positions, t)
+		}
+	}
+	return positions
+}
@@ -0,0 +1,72 @@
+package operation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestSplitPolygon(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}}
+	tests := []struct {
+		name  string
+		poly  matrix.PolygonMatrix
+		blade []matrix.LineMatrix
+		want  []matrix.PolygonMatrix
+	}{
+		{"keep hole", square, []matrix.LineMatrix{{{5, -1}, {5, 11}}},
+			[]matrix.PolygonMatrix{
+				{{{0, 0}, {5, 0}, {5, 10}, {0, 10}, {0, 0}}, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}},
+				{{{5, 0}, {10, 0}, {10, 10}, {5, 10}, {5, 0}}},
+			}},
+		{"cut hole", square, []matrix.LineMatrix{{{3, -1}, {3, 11}}},
+			[]matrix.PolygonMatrix{
+				{{{0, 0}, {3, 0}, {3, 2}, {2, 2}, {2, 4}, {3, 4}, {3, 10}, {0, 10}, {0, 0}}},
+				{{{3, 0}, {10, 0}, {10, 10}, {3, 10}, {3, 4}, {4, 4}, {4, 2}, {3, 2}, {3, 0}}},
+			}},
+		{"not cut", square, []matrix.LineMatrix{{{5, -1}, {5, 5}}}, []matrix.PolygonMatrix{square}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitPolygon(tt.poly, tt.blade); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitPolygon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitLine(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	tests := []struct {
+		name   string
+		points []matrix.Matrix
+		blade  []matrix.LineMatrix
+		want   []matrix.LineMatrix
+	}{
+		{"points", []matrix.Matrix{{5, 0}, {10, 0}, {0, 0}, {10, 5}}, nil,
+			[]matrix.LineMatrix{{{0, 0}, {5, 0}}, {{5, 0}, {10, 0}}, {{10, 0}, {10, 5}}, {{10, 5}, {10, 10}}}},
+		{"lines", nil, []matrix.LineMatrix{{{5, -1}, {5, 1}}, {{8, 0}, {12, 0}}},
+			[]matrix.LineMatrix{{{0, 0}, {5, 0}}, {{5, 0}, {8, 0}}, {{8, 0}, {10, 0}}, {{10, 0}, {10, 10}}}},
+		{"not cut", []matrix.Matrix{{5, 1}}, nil, []matrix.LineMatrix{line}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitLine(line, tt.points, tt.blade); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitLine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitLine_LonLat(t *testing.T) {
+	line := matrix.LineMatrix{{116, 39}, {116.001, 39}}
+	// the tolerance is relative to the magnitude of coordinates, not to the length of line.
+	if got := SplitLine(line, []matrix.Matrix{{116.0005, 39 + 1e-9}}, nil); len(got) != 2 ||
+		!reflect.DeepEqual(got[0][0], line[0]) || !reflect.DeepEqual(got[1][1], line[1]) {
+		t.Errorf("SplitLine() = %v, want 2 parts", got)
+	}
+	if got := SplitLine(line, []matrix.Matrix{{116.0005, 39 + 1e-6}}, nil); !reflect.DeepEqual(got, []matrix.LineMatrix{line}) {
+		t.Errorf("SplitLine() = %v, want %v", got, []matrix.LineMatrix{line})
+	}
+}
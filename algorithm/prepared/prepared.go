@@ -0,0 +1,399 @@
+// Package prepared prepares the geometry for evaluating the predicates against many other geometries,
+// the segments of geometry are indexed by the monotone chains and the points are located in its areas by the index
+// of the intervals of segments, so each evaluation doesn't build the graph of geometries.
+package prepared
+
+import (
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/operation"
+)
+
+// PreparedGeometry is the geometry with the cached indexes of its segments and areas.
+// The predicates Contains, ContainsProperly and Covers are evaluated if the geometry is polygonal,
+// the geometries should be valid. The prepared geometry is safe for concurrent use.
+type PreparedGeometry struct {
+	steric   matrix.Steric
+	points   []matrix.Matrix
+	lines    []matrix.LineMatrix
+	polygons []matrix.PolygonMatrix
+	env      *envelope.Envelope
+	segments *SegmentIndex
+	locator  *operation.IndexedPointInAreaLocator
+}
+
+// NewPreparedGeometry returns the prepared geometry of steric.
+func NewPreparedGeometry(m matrix.Steric) *PreparedGeometry {
+	p := &PreparedGeometry{steric: m}
+	p.points, p.lines, p.polygons = components(m)
+	p.env = componentsEnvelope(p.points, p.lines, p.polygons)
+	segments := append([]matrix.LineMatrix{}, p.lines...)
+	for _, pt := range p.points {
+		segments = append(segments, matrix.LineMatrix{pt})
+	}
+	for _, poly := range p.polygons {
+		for _, ring := range poly {
+			segments = append(segments, ring)
+		}
+	}
+	p.segments = NewSegmentIndex(segments...)
+	p.locator = operation.NewIndexedPointInAreaLocator(p.polygons...)
+	return p
+}
+
+// IsPolygonal returns true if the geometry only has the polygons.
+func IsPolygonal(m matrix.Steric) bool {
+	points, lines, polygons := components(m)
+	return len(points) == 0 && len(lines) == 0 && len(polygons) > 0
+}
+
+// Steric returns the geometry prepared.
+func (p *PreparedGeometry) Steric() matrix.Steric {
+	return p.steric
+}
+
+// IsPolygonal returns true if the prepared geometry only has the polygons.
+func (p *PreparedGeometry) IsPolygonal() bool {
+	return len(p.points) == 0 && len(p.lines) == 0 && len(p.polygons) > 0
+}
+
+// Locate returns the location of point in the areas of prepared geometry,
+// calc.ImInterior, calc.ImBoundary or calc.ImExterior.
+func (p *PreparedGeometry) Locate(pt matrix.Matrix) int {
+	if len(p.polygons) == 0 || !p.env.IsIntersects(envelope.Matrix(pt)) {
+		return calc.ImExterior
+	}
+	return p.locator.Locate(pt)
+}
+
+// Intersects returns true if the geometries have at least one point in common.
+func (p *PreparedGeometry) Intersects(m matrix.Steric) bool {
+	points, lines, polygons := components(m)
+	if !p.env.IsIntersects(componentsEnvelope(points, lines, polygons)) {
+		return false
+	}
+	for _, pt := range points {
+		if p.Locate(pt) != calc.ImExterior || p.intersectsLine(matrix.LineMatrix{pt, pt}) {
+			return true
+		}
+	}
+	for _, line := range lines {
+		if p.Locate(line[0]) != calc.ImExterior || p.intersectsLine(line) {
+			return true
+		}
+	}
+	for _, poly := range polygons {
+		if p.Locate(poly[0][0]) != calc.ImExterior || p.containsComponentIn(poly, false) {
+			return true
+		}
+		for _, ring := range poly {
+			if p.intersectsLine(ring) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DWithin returns true if the distance of geometries is not larger than distance.
+func (p *PreparedGeometry) DWithin(m matrix.Steric, distance float64) bool {
+	if distance < 0 {
+		return false
+	}
+	points, lines, polygons := components(m)
+	env := componentsEnvelope(points, lines, polygons)
+	if env.IsNil() || p.env.IsNil() || p.env.Distance(env) > distance {
+		return false
+	}
+	if p.Intersects(m) {
+		return true
+	}
+	within := false
+	eachSegment(points, lines, polygons, func(a, b matrix.Matrix) bool {
+		p.segments.QuerySegment(a, b, distance, func(c, d matrix.Matrix) bool {
+			within = segmentDistance(a, b, c, d) <= distance
+			return !within
+		})
+		return !within
+	})
+	return within
+}
+
+// Covers returns true if no point of the geometry is in the exterior of the prepared polygonal geometry.
+// Returns false if the prepared geometry is not polygonal.
+func (p *PreparedGeometry) Covers(m matrix.Steric) bool {
+	covers, _ := p.covers(m)
+	return covers
+}
+
+// Contains returns true if no point of the geometry is in the exterior of the prepared polygonal geometry,
+// and at least one point of its interior is in the interior of the prepared one.
+// Returns false if the prepared geometry is not polygonal.
+func (p *PreparedGeometry) Contains(m matrix.Steric) bool {
+	covers, interior := p.covers(m)
+	return covers && interior
+}
+
+// ContainsProperly returns true if all the points of the geometry are in the interior
+// of the prepared polygonal geometry, so the geometry doesn't touch its boundary.
+// Returns false if the prepared geometry is not polygonal.
+func (p *PreparedGeometry) ContainsProperly(m matrix.Steric) bool {
+	points, lines, polygons := components(m)
+	if !p.IsPolygonal() || !p.env.Covers(componentsEnvelope(points, lines, polygons)) {
+		return false
+	}
+	for _, pt := range points {
+		if p.Locate(pt) != calc.ImInterior {
+			return false
+		}
+	}
+	for _, line := range lines {
+		if p.Locate(line[0]) != calc.ImInterior || p.intersectsLine(line) {
+			return false
+		}
+	}
+	for _, poly := range polygons {
+		if p.Locate(poly[0][0]) != calc.ImInterior || p.containsComponentIn(poly, false) {
+			return false
+		}
+		for _, ring := range poly {
+			if p.intersectsLine(ring) {
+				return false
+			}
+		}
+	}
+	return len(points)+len(lines)+len(polygons) > 0
+}
+
+// Within returns true if the prepared geometry is within the polygonal geometry,
+// which is prepared to evaluate Contains. Returns false if the geometry is not polygonal.
+func (p *PreparedGeometry) Within(m matrix.Steric) bool {
+	if !IsPolygonal(m) {
+		return false
+	}
+	return NewPreparedGeometry(m).Contains(p.steric)
+}
+
+// covers returns true if the prepared polygonal geometry covers the geometry,
+// interior is true if some interior point of the geometry is in the interior of the prepared one.
+func (p *PreparedGeometry) covers(m matrix.Steric) (covers, interior bool) {
+	points, lines, polygons := components(m)
+	if !p.IsPolygonal() || len(points)+len(lines)+len(polygons) == 0 ||
+		!p.env.Covers(componentsEnvelope(points, lines, polygons)) {
+		return false, false
+	}
+	for _, pt := range points {
+		loc := p.Locate(pt)
+		if loc == calc.ImExterior {
+			return false, false
+		}
+		interior = interior || loc == calc.ImInterior
+	}
+	for _, line := range lines {
+		covered, in := p.coversLine(line)
+		if !covered {
+			return false, false
+		}
+		interior = interior || in
+	}
+	for _, poly := range polygons {
+		covered, in := true, false
+		for _, ring := range poly {
+			c, i := p.coversLine(ring)
+			covered, in = covered && c, in || i
+		}
+		if !covered || p.containsComponentIn(poly, true) {
+			return false, false
+		}
+		if !in {
+			// the boundary of polygon is on the boundary of prepared one,
+			// so its interior is either in the interior or in the exterior.
+			if pt := buffer.InteriorPoint(poly); pt == nil || p.Locate(pt) != calc.ImInterior {
+				return false, false
+			}
+		}
+		interior = true
+	}
+	return true, interior
+}
+
+// coversLine returns true if no point of line is in the exterior of the prepared areas,
+// interior is true if some point of it is in the interior of them.
+func (p *PreparedGeometry) coversLine(line matrix.LineMatrix) (covers, interior bool) {
+	if len(line) == 1 || (len(line) > 1 && allEqual(line)) {
+		loc := p.Locate(line[0])
+		return loc != calc.ImExterior, loc == calc.ImInterior
+	}
+	covers = true
+	for i := 0; i < len(line)-1 && covers; i++ {
+		noding := &segmentNoding{a: line[i], b: line[i+1]}
+		p.segments.QuerySegment(line[i], line[i+1], 0, func(c, d matrix.Matrix) bool {
+			noding.add(c, d)
+			return true
+		})
+		noding.pieces(func(mid matrix.Matrix, onBoundary bool) bool {
+			if onBoundary {
+				return true
+			}
+			switch p.Locate(mid) {
+			case calc.ImExterior:
+				covers = false
+			case calc.ImInterior:
+				interior = true
+			}
+			return covers
+		})
+	}
+	return covers, interior
+}
+
+// intersectsLine returns true if any segment of line intersects the segments of prepared geometry.
+func (p *PreparedGeometry) intersectsLine(line matrix.LineMatrix) bool {
+	if len(line) == 1 {
+		line = matrix.LineMatrix{line[0], line[0]}
+	}
+	found := false
+	for i := 0; i < len(line)-1 && !found; i++ {
+		a, b := line[i], line[i+1]
+		p.segments.QuerySegment(a, b, 0, func(c, d matrix.Matrix) bool {
+			nodes, _ := segmentIntersections(a, b, c, d)
+			found = len(nodes) > 0
+			return !found
+		})
+	}
+	return found
+}
+
+// containsComponentIn returns true if any part of the prepared geometry is in the interior of polygon,
+// or in its interior or boundary if interiorOnly is false. If interiorOnly is true, the segments of
+// prepared geometry are divided by the rings of polygon, otherwise it's only known to be inside
+// if the prepared geometry doesn't intersect the rings.
+func (p *PreparedGeometry) containsComponentIn(poly matrix.PolygonMatrix, interiorOnly bool) bool {
+	locator := operation.NewIndexedPointInAreaLocator(poly)
+	env := lineEnvelope(poly[0])
+	if !interiorOnly {
+		for _, pt := range p.representativePoints() {
+			if env.IsIntersects(envelope.Matrix(pt)) && locator.Locate(pt) != calc.ImExterior {
+				return true
+			}
+		}
+		return false
+	}
+	inside := false
+	p.segments.Query(env, func(a, b matrix.Matrix) bool {
+		noding := &segmentNoding{a: a, b: b}
+		for _, ring := range poly {
+			for i := 0; i < len(ring)-1; i++ {
+				noding.add(ring[i], ring[i+1])
+			}
+		}
+		noding.pieces(func(mid matrix.Matrix, onBoundary bool) bool {
+			inside = !onBoundary && locator.Locate(mid) == calc.ImInterior
+			return !inside
+		})
+		return !inside
+	})
+	return inside
+}
+
+// representativePoints returns a point of each component of the prepared geometry.
+func (p *PreparedGeometry) representativePoints() []matrix.Matrix {
+	pts := append([]matrix.Matrix{}, p.points...)
+	for _, line := range p.lines {
+		pts = append(pts, line[0])
+	}
+	for _, poly := range p.polygons {
+		for _, ring := range poly {
+			pts = append(pts, ring[0])
+		}
+	}
+	return pts
+}
+
+// components returns the points, lines and polygons of the geometry, the empty ones are omitted.
+func components(m matrix.Steric) (points []matrix.Matrix, lines []matrix.LineMatrix, polygons []matrix.PolygonMatrix) {
+	switch mm := m.(type) {
+	case matrix.Matrix:
+		if len(mm) >= 2 {
+			points = append(points, mm)
+		}
+	case matrix.LineMatrix:
+		if len(mm) > 0 {
+			lines = append(lines, mm)
+		}
+	case matrix.PolygonMatrix:
+		if len(mm) > 0 && len(mm[0]) > 0 {
+			polygons = append(polygons, mm)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, poly := range mm {
+			if len(poly) > 0 && len(poly[0]) > 0 {
+				polygons = append(polygons, poly)
+			}
+		}
+	case matrix.Collection:
+		for _, v := range mm {
+			pts, ls, polys := components(v)
+			points, lines, polygons = append(points, pts...), append(lines, ls...), append(polygons, polys...)
+		}
+	}
+	return
+}
+
+// componentsEnvelope returns the envelope of the components.
+func componentsEnvelope(points []matrix.Matrix, lines []matrix.LineMatrix, polygons []matrix.PolygonMatrix) *envelope.Envelope {
+	env := envelope.MatrixList(points)
+	for _, line := range lines {
+		env.ExpandToIncludeEnv(lineEnvelope(line))
+	}
+	for _, poly := range polygons {
+		env.ExpandToIncludeEnv(lineEnvelope(poly[0]))
+	}
+	return env
+}
+
+// lineEnvelope returns the envelope of line.
+func lineEnvelope(line matrix.LineMatrix) *envelope.Envelope {
+	env := envelope.Empty()
+	for _, p := range line {
+		env.ExpandToInclude(p[0], p[1])
+	}
+	return env
+}
+
+// eachSegment calls f for the segments of components until f returns false,
+// the point is the segment of no length.
+func eachSegment(points []matrix.Matrix, lines []matrix.LineMatrix, polygons []matrix.PolygonMatrix,
+	f func(a, b matrix.Matrix) bool) {
+	all := append([]matrix.LineMatrix{}, lines...)
+	for _, pt := range points {
+		all = append(all, matrix.LineMatrix{pt, pt})
+	}
+	for _, poly := range polygons {
+		for _, ring := range poly {
+			all = append(all, ring)
+		}
+	}
+	for _, line := range all {
+		if len(line) == 1 {
+			line = matrix.LineMatrix{line[0], line[0]}
+		}
+		for i := 0; i < len(line)-1; i++ {
+			if !f(line[i], line[i+1]) {
+				return
+			}
+		}
+	}
+}
+
+// allEqual returns true if all the points of line are equal.
+func allEqual(line matrix.LineMatrix) bool {
+	for _, pt := range line[1:] {
+		if pt[0] != line[0][0] || pt[1] != line[0][1] {
+			return false
+		}
+	}
+	return true
+}
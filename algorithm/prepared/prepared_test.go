@@ -0,0 +1,169 @@
+package prepared
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+var (
+	square = matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	holed  = matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}}
+)
+
+func TestPreparedGeometry_Predicates(t *testing.T) {
+	tests := []struct {
+		name                                           string
+		prepared                                       matrix.Steric
+		geom                                           matrix.Steric
+		intersects, covers, contains, containsProperly bool
+	}{
+		{name: "point interior", prepared: square, geom: matrix.Matrix{2, 2},
+			intersects: true, covers: true, contains: true, containsProperly: true},
+		{name: "point boundary", prepared: square, geom: matrix.Matrix{0, 5},
+			intersects: true, covers: true},
+		{name: "point exterior", prepared: square, geom: matrix.Matrix{12, 5}},
+		{name: "point in hole", prepared: holed, geom: matrix.Matrix{5, 5}},
+		{name: "line interior", prepared: square, geom: matrix.LineMatrix{{1, 1}, {9, 9}},
+			intersects: true, covers: true, contains: true, containsProperly: true},
+		{name: "line crossing hole", prepared: holed, geom: matrix.LineMatrix{{1, 1}, {9, 9}},
+			intersects: true},
+		{name: "line touching hole", prepared: holed, geom: matrix.LineMatrix{{1, 4}, {5, 4}, {5, 1}},
+			intersects: true, covers: true, contains: true},
+		{name: "line on boundary", prepared: square, geom: matrix.LineMatrix{{0, 0}, {10, 0}},
+			intersects: true, covers: true},
+		{name: "line crossing", prepared: square, geom: matrix.LineMatrix{{5, 5}, {15, 5}},
+			intersects: true},
+		{name: "line exterior", prepared: square, geom: matrix.LineMatrix{{11, 0}, {11, 10}}},
+		{name: "line touching vertex", prepared: square, geom: matrix.LineMatrix{{-5, 5}, {5, 15}},
+			intersects: true},
+		{name: "itself", prepared: square, geom: square,
+			intersects: true, covers: true, contains: true},
+		{name: "polygon inside", prepared: square, geom: matrix.PolygonMatrix{{{1, 1}, {2, 1}, {2, 2}, {1, 1}}},
+			intersects: true, covers: true, contains: true, containsProperly: true},
+		{name: "polygon over hole", prepared: holed, geom: matrix.PolygonMatrix{{{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}},
+			intersects: true},
+		{name: "polygon with the hole", prepared: holed,
+			geom:       matrix.PolygonMatrix{{{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}},
+			intersects: true, covers: true, contains: true},
+		{name: "polygon is the hole", prepared: holed, geom: matrix.PolygonMatrix{{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}},
+			intersects: true},
+		{name: "polygon containing", prepared: matrix.PolygonMatrix{{{1, 1}, {2, 1}, {2, 2}, {1, 1}}}, geom: square,
+			intersects: true},
+		{name: "polygon overlapping", prepared: square, geom: matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}, {5, 5}}},
+			intersects: true},
+		{name: "collection", prepared: matrix.MultiPolygonMatrix{square, {{{20, 0}, {30, 0}, {30, 10}, {20, 0}}}},
+			geom:       matrix.Collection{matrix.Matrix{2, 2}, matrix.LineMatrix{{25, 1}, {29, 1}}},
+			intersects: true, covers: true, contains: true, containsProperly: true},
+		{name: "line prepared", prepared: matrix.LineMatrix{{0, 0}, {10, 10}}, geom: matrix.LineMatrix{{0, 10}, {10, 0}},
+			intersects: true},
+		{name: "point on prepared line", prepared: matrix.LineMatrix{{0, 0}, {10, 10}}, geom: matrix.Matrix{3, 3},
+			intersects: true},
+		{name: "point prepared", prepared: matrix.Matrix{3, 3}, geom: matrix.Matrix{3, 3},
+			intersects: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPreparedGeometry(tt.prepared)
+			if got := p.Intersects(tt.geom); got != tt.intersects {
+				t.Errorf("Intersects() = %v, want %v", got, tt.intersects)
+			}
+			if got := p.Covers(tt.geom); got != tt.covers {
+				t.Errorf("Covers() = %v, want %v", got, tt.covers)
+			}
+			if got := p.Contains(tt.geom); got != tt.contains {
+				t.Errorf("Contains() = %v, want %v", got, tt.contains)
+			}
+			if got := p.ContainsProperly(tt.geom); got != tt.containsProperly {
+				t.Errorf("ContainsProperly() = %v, want %v", got, tt.containsProperly)
+			}
+			if got := NewPreparedGeometry(tt.geom).Intersects(tt.prepared); got != tt.intersects {
+				t.Errorf("Intersects() reversed = %v, want %v", got, tt.intersects)
+			}
+		})
+	}
+}
+
+func TestPreparedGeometry_Within(t *testing.T) {
+	p := NewPreparedGeometry(matrix.PolygonMatrix{{{1, 1}, {2, 1}, {2, 2}, {1, 1}}})
+	if !p.Within(square) {
+		t.Errorf("Within() = false, want true")
+	}
+	if !p.Within(holed) || p.Within(matrix.PolygonMatrix{{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}}) {
+		t.Errorf("Within() of polygons is wrong")
+	}
+	if p.Within(matrix.LineMatrix{{1, 1}, {2, 1}}) {
+		t.Errorf("Within() of line = true, want false")
+	}
+	if !NewPreparedGeometry(matrix.Matrix{5, 5}).Within(square) {
+		t.Errorf("Within() of point = false, want true")
+	}
+}
+
+func TestPreparedGeometry_DWithin(t *testing.T) {
+	p := NewPreparedGeometry(holed)
+	tests := []struct {
+		name     string
+		geom     matrix.Steric
+		distance float64
+		want     bool
+	}{
+		{"inside", matrix.Matrix{2, 2}, 0, true},
+		{"in hole", matrix.Matrix{5, 5}, 1, true},
+		{"in hole beyond", matrix.Matrix{5, 5}, 0.5, false},
+		{"near line", matrix.LineMatrix{{12, -5}, {12, 15}}, 2, true},
+		{"far line", matrix.LineMatrix{{12, -5}, {12, 15}}, 1.9, false},
+		{"diagonal", matrix.LineMatrix{{9, 20}, {20, 9}}, 6, false},
+		{"diagonal near", matrix.LineMatrix{{9, 20}, {20, 9}}, 6.4, true},
+		{"negative", matrix.Matrix{2, 2}, -1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.DWithin(tt.geom, tt.distance); got != tt.want {
+				t.Errorf("DWithin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreparedGeometry_ManySegments(t *testing.T) {
+	// a comb whose teeth are narrow, so the index is queried among many chains.
+	ring := matrix.LineMatrix{{0, 0}}
+	for i := 0; i < 100; i++ {
+		x := float64(i)
+		ring = append(ring, matrix.Matrix{x, 10}, matrix.Matrix{x + 0.5, 10}, matrix.Matrix{x + 0.5, 1}, matrix.Matrix{x + 1, 1})
+	}
+	ring = append(ring, matrix.Matrix{100, 0}, matrix.Matrix{0, 0})
+	p := NewPreparedGeometry(matrix.PolygonMatrix{ring})
+	for i := 0; i < 100; i++ {
+		x := float64(i)
+		if !p.ContainsProperly(matrix.Matrix{x + 0.25, 5}) {
+			t.Errorf("ContainsProperly() of tooth %v = false, want true", i)
+		}
+		if p.Intersects(matrix.Matrix{x + 0.75, 5}) {
+			t.Errorf("Intersects() of gap %v = true, want false", i)
+		}
+		if !p.Covers(matrix.LineMatrix{{x + 0.25, 0.5}, {x + 0.25, 9}}) {
+			t.Errorf("Covers() of line in tooth %v = false, want true", i)
+		}
+		if p.Covers(matrix.LineMatrix{{x + 0.25, 5}, {x + 1.25, 5}}) {
+			t.Errorf("Covers() of line across gap %v = true, want false", i)
+		}
+	}
+}
+
+func TestPreparedGeometry_Concurrent(t *testing.T) {
+	p := NewPreparedGeometry(holed)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !p.Contains(matrix.Matrix{2, 2}) || p.Contains(matrix.Matrix{5, 5}) {
+				t.Error("PreparedGeometry.Contains() is wrong when evaluated concurrently")
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,193 @@
+package prepared
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/overlay/chain"
+	"github.com/spatial-go/geoos/index/intervalrtree"
+)
+
+// SegmentIndex Indexes the segments of lines by their monotone chains,
+// the envelope of a chain is the one of its endpoints, so the chains are stored by the x intervals of them
+// and the chains found are filtered by their envelopes.
+type SegmentIndex struct {
+	index  *intervalrtree.SortedPackedIntervalRTree
+	chains int
+}
+
+// NewSegmentIndex returns the index of the segments of lines, the line of a single point is the segment of no length.
+func NewSegmentIndex(lines ...matrix.LineMatrix) *SegmentIndex {
+	s := &SegmentIndex{index: &intervalrtree.SortedPackedIntervalRTree{}}
+	for _, line := range lines {
+		if len(line) == 1 {
+			line = matrix.LineMatrix{line[0], line[0]}
+		}
+		for _, mc := range chain.Chains(line) {
+			// the index is not queried yet, so the chain is inserted.
+			_ = s.index.Insert(chainEnvelope(mc), mc)
+			s.chains++
+		}
+	}
+	return s
+}
+
+// Query calls f for the indexed segments whose envelopes intersect the envelope, until f returns false.
+func (s *SegmentIndex) Query(env *envelope.Envelope, f func(a, b matrix.Matrix) bool) {
+	if s.chains == 0 {
+		return
+	}
+	for _, item := range s.index.Query(env).([]interface{}) {
+		mc := item.(*chain.MonotoneChain)
+		if !chainEnvelope(mc).IsIntersects(env) {
+			continue
+		}
+		for i := mc.Start; i < mc.End; i++ {
+			a, b := mc.Edge[i], mc.Edge[i+1]
+			if envelope.TwoMatrix(a, b).IsIntersects(env) && !f(a, b) {
+				return
+			}
+		}
+	}
+}
+
+// QuerySegment calls f for the indexed segments whose envelopes are within distance of the envelope of segment ab,
+// until f returns false.
+func (s *SegmentIndex) QuerySegment(a, b matrix.Matrix, distance float64, f func(c, d matrix.Matrix) bool) {
+	env := envelope.TwoMatrix(a, b)
+	if distance > 0 {
+		env.ExpandBy(distance)
+	}
+	s.Query(env, f)
+}
+
+// chainEnvelope returns the envelope of the monotone chain, which is the one of its endpoints.
+func chainEnvelope(mc *chain.MonotoneChain) *envelope.Envelope {
+	return envelope.TwoMatrix(mc.Edge[mc.Start], mc.Edge[mc.End])
+}
+
+// segmentNoding collects the intersections of the other segments along the segment ab,
+// as the fractions of ab from a.
+type segmentNoding struct {
+	a, b     matrix.Matrix
+	nodes    []float64
+	overlaps [][2]float64
+}
+
+// add adds the intersections of segment cd, returns true if they intersect.
+func (n *segmentNoding) add(c, d matrix.Matrix) bool {
+	nodes, collinear := segmentIntersections(n.a, n.b, c, d)
+	n.nodes = append(n.nodes, nodes...)
+	if collinear && len(nodes) == 2 {
+		n.overlaps = append(n.overlaps, [2]float64{nodes[0], nodes[1]})
+	}
+	return len(nodes) > 0
+}
+
+// pieces calls f for the parts of segment between the intersections with the midpoint of part,
+// onOther is true if the part overlaps the other segments.
+func (n *segmentNoding) pieces(f func(mid matrix.Matrix, onOther bool) bool) {
+	nodes := append([]float64{0, 1}, n.nodes...)
+	sort.Float64s(nodes)
+	for i := 0; i < len(nodes)-1; i++ {
+		t0, t1 := nodes[i], nodes[i+1]
+		if t0 == t1 {
+			continue
+		}
+		onOther := false
+		for _, o := range n.overlaps {
+			if o[0] <= t0 && t1 <= o[1] {
+				onOther = true
+				break
+			}
+		}
+		t := (t0 + t1) / 2
+		mid := matrix.Matrix{n.a[0] + t*(n.b[0]-n.a[0]), n.a[1] + t*(n.b[1]-n.a[1])}
+		if !f(mid, onOther) {
+			return
+		}
+	}
+}
+
+// segmentIntersections returns the intersections of segments ab and cd as the fractions of ab from a,
+// collinear is true if they are collinear, whose intersections are the ends of the overlap in order.
+func segmentIntersections(a, b, c, d matrix.Matrix) (nodes []float64, collinear bool) {
+	if !envelope.IsIntersectsTwo(a, b, c, d) {
+		return nil, false
+	}
+	if a[0] == b[0] && a[1] == b[1] {
+		if onSegment(a, c, d) {
+			return []float64{0}, false
+		}
+		return nil, false
+	}
+	o1 := calc.OrientationIndex(a[0], a[1], b[0], b[1], c[0], c[1])
+	o2 := calc.OrientationIndex(a[0], a[1], b[0], b[1], d[0], d[1])
+	o3 := calc.OrientationIndex(c[0], c[1], d[0], d[1], a[0], a[1])
+	o4 := calc.OrientationIndex(c[0], c[1], d[0], d[1], b[0], b[1])
+	if o1 == 0 && o2 == 0 {
+		tc, td := fraction(c, a, b), fraction(d, a, b)
+		t0, t1 := math.Max(0, math.Min(tc, td)), math.Min(1, math.Max(tc, td))
+		switch {
+		case t0 < t1:
+			return []float64{t0, t1}, true
+		case t0 == t1:
+			return []float64{t0}, true
+		}
+		return nil, true
+	}
+	if o1*o2 < 0 && o3*o4 < 0 {
+		// the proper intersection.
+		rx, ry, sx, sy := b[0]-a[0], b[1]-a[1], d[0]-c[0], d[1]-c[1]
+		t := ((c[0]-a[0])*sy - (c[1]-a[1])*sx) / (rx*sy - ry*sx)
+		return []float64{math.Max(0, math.Min(1, t))}, false
+	}
+	if o1 == 0 && onSegment(c, a, b) {
+		nodes = append(nodes, fraction(c, a, b))
+	}
+	if o2 == 0 && onSegment(d, a, b) {
+		nodes = append(nodes, fraction(d, a, b))
+	}
+	if o3 == 0 && onSegment(a, c, d) {
+		nodes = append(nodes, 0)
+	}
+	if o4 == 0 && onSegment(b, c, d) {
+		nodes = append(nodes, 1)
+	}
+	return nodes, false
+}
+
+// segmentDistance returns the distance of segments ab and cd.
+func segmentDistance(a, b, c, d matrix.Matrix) float64 {
+	if nodes, _ := segmentIntersections(a, b, c, d); len(nodes) > 0 {
+		return 0
+	}
+	return math.Min(math.Min(pointSegmentDistance(a, c, d), pointSegmentDistance(b, c, d)),
+		math.Min(pointSegmentDistance(c, a, b), pointSegmentDistance(d, a, b)))
+}
+
+// pointSegmentDistance returns the distance of point p to segment ab.
+func pointSegmentDistance(p, a, b matrix.Matrix) float64 {
+	t := math.Max(0, math.Min(1, fraction(p, a, b)))
+	return math.Hypot(p[0]-(a[0]+t*(b[0]-a[0])), p[1]-(a[1]+t*(b[1]-a[1])))
+}
+
+// fraction returns the fraction of the projection of p onto the line ab from a.
+func fraction(p, a, b matrix.Matrix) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	len2 := dx*dx + dy*dy
+	if len2 == 0 {
+		return 0
+	}
+	return ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / len2
+}
+
+// onSegment returns true if p is on segment ab.
+func onSegment(p, a, b matrix.Matrix) bool {
+	return calc.OrientationIndex(a[0], a[1], b[0], b[1], p[0], p[1]) == 0 &&
+		math.Min(a[0], b[0]) <= p[0] && p[0] <= math.Max(a[0], b[0]) &&
+		math.Min(a[1], b[1]) <= p[1] && p[1] <= math.Max(a[1], b[1])
+}
@@ -0,0 +1,39 @@
+package relate
+
+// BoundaryNodeRule Determines whether the endpoints of lines are in the boundary of the lineal geometry,
+// by the number of the endpoints of lines at the point.
+type BoundaryNodeRule int
+
+// the boundary node rules.
+const (
+	// Mod2BoundaryNodeRule the point is in the boundary if it is the endpoint of an odd number of lines,
+	// which is the rule of OGC SFS, so the closed lines have no boundary.
+	Mod2BoundaryNodeRule BoundaryNodeRule = iota
+
+	// EndPointBoundaryNodeRule all the endpoints of lines are in the boundary, including the ones of closed lines.
+	EndPointBoundaryNodeRule
+
+	// MultivalentEndPointBoundaryNodeRule the point is in the boundary if it is the endpoint of more than one line.
+	MultivalentEndPointBoundaryNodeRule
+
+	// MonovalentEndPointBoundaryNodeRule the point is in the boundary if it is the endpoint of only one line.
+	MonovalentEndPointBoundaryNodeRule
+)
+
+// IsInBoundary returns true if the point which is the endpoint of count lines is in the boundary.
+func (r BoundaryNodeRule) IsInBoundary(count int) bool {
+	switch r {
+	case EndPointBoundaryNodeRule:
+		return count > 0
+	case MultivalentEndPointBoundaryNodeRule:
+		return count > 1
+	case MonovalentEndPointBoundaryNodeRule:
+		return count == 1
+	}
+	return count%2 == 1
+}
+
+// IsValid returns true if the rule is one of the boundary node rules.
+func (r BoundaryNodeRule) IsValid() bool {
+	return r >= Mod2BoundaryNodeRule && r <= MonovalentEndPointBoundaryNodeRule
+}
@@ -0,0 +1,355 @@
+package relate
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/operation"
+)
+
+// IMBoundaryNodeRule Gets the relate for the spatial relationship between the input geometries,
+// the boundaries of lineal geometries are determined by the boundary node rule.
+// The edges of geometries are noded by each other, so the nodes and the parts of edges between them are located
+// in both geometries, the areas are labeled by the sides of the parts of rings.
+func IMBoundaryNodeRule(g0, g1 matrix.Steric, rule BoundaryNodeRule) (*matrix.IntersectionMatrix, error) {
+	if !rule.IsValid() {
+		return nil, algorithm.ErrWrongBoundaryNodeRule
+	}
+	r0, r1 := newRuleGeometry(g0, rule), newRuleGeometry(g1, rule)
+	im := matrix.IntersectionMatrixDefault()
+	im.Set(calc.ImExterior, calc.ImExterior, calc.ImA)
+	if len(r0.polygons) > 0 && len(r1.polygons) == 0 {
+		im.Set(calc.ImInterior, calc.ImExterior, calc.ImA)
+	}
+	if len(r1.polygons) > 0 && len(r0.polygons) == 0 {
+		im.Set(calc.ImExterior, calc.ImInterior, calc.ImA)
+	}
+	for _, p := range append(r0.vertices(), r1.vertices()...) {
+		im.SetAtLeast(r0.locate(p), r1.locate(p), calc.ImP)
+	}
+	computeEdgesIM(r0, r1, im, false)
+	computeEdgesIM(r1, r0, im, true)
+	return im, nil
+}
+
+// RelateBoundaryNodeRule Gets the relate string for the spatial relationship between the input geometries,
+// the boundaries of lineal geometries are determined by the boundary node rule.
+func RelateBoundaryNodeRule(g0, g1 matrix.Steric, rule BoundaryNodeRule) (string, error) {
+	im, err := IMBoundaryNodeRule(g0, g1, rule)
+	if err != nil {
+		return "", err
+	}
+	return im.ToString(), nil
+}
+
+// computeEdgesIM sets the relate of the parts of edges of r noded by the edges of other,
+// the rows and columns are exchanged if transpose.
+func computeEdgesIM(r, other *ruleGeometry, im *matrix.IntersectionMatrix, transpose bool) {
+	set := func(row, column, dimension int) {
+		if transpose {
+			row, column = column, row
+		}
+		im.SetAtLeast(row, column, dimension)
+	}
+	otherEdges := other.edges()
+	for _, e := range r.edges() {
+		nodes := []float64{0, 1}
+		var overlaps []edgeOverlap
+		for _, f := range otherEdges {
+			fractions, proper, collinear := edgeIntersection(e, f)
+			nodes = append(nodes, fractions...)
+			if proper {
+				// the crossing isn't a vertex, it's in the interior of edges.
+				set(e.location(), f.location(), calc.ImP)
+			}
+			if collinear && len(fractions) == 2 {
+				overlaps = append(overlaps, edgeOverlap{fractions[0], fractions[1], f})
+			}
+		}
+		sort.Float64s(nodes)
+		for i := 0; i < len(nodes)-1; i++ {
+			t0, t1 := nodes[i], nodes[i+1]
+			if t0 == t1 {
+				continue
+			}
+			var onOther *ruleEdge
+			for j, o := range overlaps {
+				if o.t0 <= t0 && t1 <= o.t1 {
+					if onOther == nil || overlaps[j].edge.ring {
+						onOther = &overlaps[j].edge
+					}
+				}
+			}
+			t := (t0 + t1) / 2
+			mid := matrix.Matrix{e.a[0] + t*(e.b[0]-e.a[0]), e.a[1] + t*(e.b[1]-e.a[1])}
+			loc := calc.ImBoundary
+			if !e.ring {
+				if loc = r.locateEdges(mid); loc == calc.ImExterior {
+					loc = calc.ImInterior
+				}
+			}
+			// the part has length, so it is located in the areas and lines of other only,
+			// the points of other are located by the vertices at calc.ImP.
+			otherLoc := other.locateEdges(mid)
+			if onOther != nil {
+				if onOther.ring {
+					otherLoc = calc.ImBoundary
+				} else if otherLoc == calc.ImExterior {
+					otherLoc = calc.ImInterior
+				}
+			}
+			set(loc, otherLoc, calc.ImL)
+			if !e.ring {
+				continue
+			}
+			// the interior of ring is on one side of the part and the exterior is on the other side.
+			switch {
+			case onOther != nil && onOther.ring:
+				sameDirection := (e.b[0]-e.a[0])*(onOther.b[0]-onOther.a[0])+(e.b[1]-e.a[1])*(onOther.b[1]-onOther.a[1]) > 0
+				if sameDirection == (e.interiorLeft == onOther.interiorLeft) {
+					set(calc.ImInterior, calc.ImInterior, calc.ImA)
+					set(calc.ImExterior, calc.ImExterior, calc.ImA)
+				} else {
+					set(calc.ImInterior, calc.ImExterior, calc.ImA)
+					set(calc.ImExterior, calc.ImInterior, calc.ImA)
+				}
+			case other.locateAreas(mid) == calc.ImInterior:
+				set(calc.ImInterior, calc.ImInterior, calc.ImA)
+				set(calc.ImExterior, calc.ImInterior, calc.ImA)
+			default:
+				set(calc.ImInterior, calc.ImExterior, calc.ImA)
+				set(calc.ImExterior, calc.ImExterior, calc.ImA)
+			}
+		}
+	}
+}
+
+// ruleGeometry is the geometry decomposed to the points, lines and polygons,
+// the boundary of its lines is determined by the boundary node rule.
+type ruleGeometry struct {
+	points   []matrix.Matrix
+	lines    []matrix.LineMatrix
+	polygons []matrix.PolygonMatrix
+	boundary map[[2]float64]bool
+	locator  *operation.IndexedPointInAreaLocator
+}
+
+// newRuleGeometry returns the ruleGeometry of steric, the line of no length is the point.
+func newRuleGeometry(m matrix.Steric, rule BoundaryNodeRule) *ruleGeometry {
+	r := &ruleGeometry{boundary: map[[2]float64]bool{}}
+	r.add(m)
+	counts := map[[2]float64]int{}
+	for _, line := range r.lines {
+		counts[pointKey(line[0])]++
+		counts[pointKey(line[len(line)-1])]++
+	}
+	for k, count := range counts {
+		if rule.IsInBoundary(count) {
+			r.boundary[k] = true
+		}
+	}
+	r.locator = operation.NewIndexedPointInAreaLocator(r.polygons...)
+	return r
+}
+
+// add adds the components of steric.
+func (r *ruleGeometry) add(m matrix.Steric) {
+	switch m := m.(type) {
+	case matrix.Matrix:
+		r.points = append(r.points, m)
+	case matrix.LineMatrix:
+		if len(m) == 0 {
+			return
+		}
+		for _, v := range m[1:] {
+			if !matrix.Matrix(v).Equals(matrix.Matrix(m[0])) {
+				r.lines = append(r.lines, m)
+				return
+			}
+		}
+		r.points = append(r.points, m[0])
+	case matrix.PolygonMatrix:
+		if len(m) > 0 && len(m[0]) > 0 {
+			r.polygons = append(r.polygons, m)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range m {
+			r.add(matrix.PolygonMatrix(v))
+		}
+	case matrix.Collection:
+		for _, v := range m {
+			r.add(v)
+		}
+	}
+}
+
+// locate returns the location of point in the geometry,
+// the interiors of areas take precedence over the boundaries, which take precedence over the interiors of lines.
+func (r *ruleGeometry) locate(p matrix.Matrix) int {
+	if loc := r.locateEdges(p); loc != calc.ImExterior {
+		return loc
+	}
+	for _, v := range r.points {
+		if v.Equals(p) {
+			return calc.ImInterior
+		}
+	}
+	return calc.ImExterior
+}
+
+// locateEdges returns the location of point in the polygons and lines of geometry, its points are not located.
+func (r *ruleGeometry) locateEdges(p matrix.Matrix) int {
+	if loc := r.locateAreas(p); loc != calc.ImExterior {
+		return loc
+	}
+	if r.boundary[pointKey(p)] {
+		return calc.ImBoundary
+	}
+	for _, line := range r.lines {
+		for i := 0; i < len(line)-1; i++ {
+			if onSegment(p, line[i], line[i+1]) {
+				return calc.ImInterior
+			}
+		}
+	}
+	return calc.ImExterior
+}
+
+// locateAreas returns the location of point in the polygons of geometry.
+func (r *ruleGeometry) locateAreas(p matrix.Matrix) int {
+	if len(r.polygons) == 0 {
+		return calc.ImExterior
+	}
+	return r.locator.Locate(p)
+}
+
+// vertices returns the points and the vertices of lines and polygons.
+func (r *ruleGeometry) vertices() []matrix.Matrix {
+	vertices := append([]matrix.Matrix{}, r.points...)
+	for _, line := range r.lines {
+		for _, v := range line {
+			vertices = append(vertices, v)
+		}
+	}
+	for _, poly := range r.polygons {
+		for _, ring := range poly {
+			for _, v := range ring {
+				vertices = append(vertices, v)
+			}
+		}
+	}
+	return vertices
+}
+
+// edges returns the segments of lines and rings which have length.
+func (r *ruleGeometry) edges() []ruleEdge {
+	var edges []ruleEdge
+	for _, line := range r.lines {
+		for i := 0; i < len(line)-1; i++ {
+			if !matrix.Matrix(line[i]).Equals(matrix.Matrix(line[i+1])) {
+				edges = append(edges, ruleEdge{a: line[i], b: line[i+1]})
+			}
+		}
+	}
+	for _, poly := range r.polygons {
+		for j, ring := range poly {
+			// the interior of polygon is on the left of shell in counter clockwise and of hole in clockwise.
+			interiorLeft := (j == 0) == (signedArea(ring) > 0)
+			for i := 0; i < len(ring)-1; i++ {
+				if !matrix.Matrix(ring[i]).Equals(matrix.Matrix(ring[i+1])) {
+					edges = append(edges, ruleEdge{a: ring[i], b: ring[i+1], ring: true, interiorLeft: interiorLeft})
+				}
+			}
+		}
+	}
+	return edges
+}
+
+// ruleEdge is the segment of line or ring.
+type ruleEdge struct {
+	a, b         matrix.Matrix
+	ring         bool
+	interiorLeft bool
+}
+
+// location returns the location of the interior points of edge in its geometry.
+func (e ruleEdge) location() int {
+	if e.ring {
+		return calc.ImBoundary
+	}
+	return calc.ImInterior
+}
+
+// edgeOverlap is the part of edge from t0 to t1 overlapping the other edge.
+type edgeOverlap struct {
+	t0, t1 float64
+	edge   ruleEdge
+}
+
+// edgeIntersection returns the intersections of edges e and f as the fractions of e,
+// proper is true if they cross at the interior point of both, collinear is true if they are collinear,
+// whose intersections are the ends of the overlap in order.
+func edgeIntersection(e, f ruleEdge) (fractions []float64, proper, collinear bool) {
+	a, b, c, d := e.a, e.b, f.a, f.b
+	if math.Max(a[0], b[0]) < math.Min(c[0], d[0]) || math.Max(c[0], d[0]) < math.Min(a[0], b[0]) ||
+		math.Max(a[1], b[1]) < math.Min(c[1], d[1]) || math.Max(c[1], d[1]) < math.Min(a[1], b[1]) {
+		return nil, false, false
+	}
+	o1 := calc.OrientationIndex(a[0], a[1], b[0], b[1], c[0], c[1])
+	o2 := calc.OrientationIndex(a[0], a[1], b[0], b[1], d[0], d[1])
+	o3 := calc.OrientationIndex(c[0], c[1], d[0], d[1], a[0], a[1])
+	o4 := calc.OrientationIndex(c[0], c[1], d[0], d[1], b[0], b[1])
+	if o1 == 0 && o2 == 0 {
+		tc, td := fraction(c, a, b), fraction(d, a, b)
+		t0, t1 := math.Max(0, math.Min(tc, td)), math.Min(1, math.Max(tc, td))
+		switch {
+		case t0 < t1:
+			return []float64{t0, t1}, false, true
+		case t0 == t1:
+			return []float64{t0}, false, true
+		}
+		return nil, false, true
+	}
+	if o1*o2 < 0 && o3*o4 < 0 {
+		rx, ry, sx, sy := b[0]-a[0], b[1]-a[1], d[0]-c[0], d[1]-c[1]
+		t := ((c[0]-a[0])*sy - (c[1]-a[1])*sx) / (rx*sy - ry*sx)
+		return []float64{math.Max(0, math.Min(1, t))}, true, false
+	}
+	if o1 == 0 && onSegment(c, a, b) {
+		fractions = append(fractions, fraction(c, a, b))
+	}
+	if o2 == 0 && onSegment(d, a, b) {
+		fractions = append(fractions, fraction(d, a, b))
+	}
+	return fractions, false, false
+}
+
+// fraction returns the fraction of the projection of p onto the line ab from a.
+func fraction(p, a, b matrix.Matrix) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	return ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / (dx*dx + dy*dy)
+}
+
+// onSegment returns true if p is on segment ab.
+func onSegment(p, a, b matrix.Matrix) bool {
+	return calc.OrientationIndex(a[0], a[1], b[0], b[1], p[0], p[1]) == 0 &&
+		math.Min(a[0], b[0]) <= p[0] && p[0] <= math.Max(a[0], b[0]) &&
+		math.Min(a[1], b[1]) <= p[1] && p[1] <= math.Max(a[1], b[1])
+}
+
+// signedArea returns the signed area of ring, which is positive if the ring is counter clockwise.
+func signedArea(ring matrix.LineMatrix) float64 {
+	sum := 0.0
+	for i := 0; i < len(ring)-1; i++ {
+		sum += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+	}
+	return sum / 2
+}
+
+// pointKey returns the key of point in the map.
+func pointKey(p matrix.Matrix) [2]float64 {
+	return [2]float64{p[0], p[1]}
+}
@@ -0,0 +1,62 @@
+package relate
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestIMBoundaryNodeRule(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	hole := matrix.PolygonMatrix{{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}}
+	holed := matrix.PolygonMatrix{square[0], {{4, 4}, {4, 6}, {6, 6}, {6, 4}, {4, 4}}}
+	joined := matrix.Collection{matrix.LineMatrix{{0, 0}, {1, 1}}, matrix.LineMatrix{{1, 1}, {2, 2}}}
+	closed := matrix.LineMatrix{{0, 0}, {1, 0}, {1, 1}, {0, 0}}
+	tests := []struct {
+		name   string
+		g0, g1 matrix.Steric
+		rule   BoundaryNodeRule
+		want   string
+	}{
+		{"lines crossing", matrix.LineMatrix{{0, 0}, {2, 2}}, matrix.LineMatrix{{0, 2}, {2, 0}}, Mod2BoundaryNodeRule, "0F1FF0102"},
+		{"lines touching", matrix.LineMatrix{{0, 0}, {1, 1}}, matrix.LineMatrix{{1, 1}, {2, 2}}, Mod2BoundaryNodeRule, "FF1F00102"},
+		{"lines overlapping", matrix.LineMatrix{{0, 0}, {2, 0}}, matrix.LineMatrix{{1, 0}, {3, 0}}, Mod2BoundaryNodeRule, "1010F0102"},
+		{"point on endpoint", matrix.Matrix{0, 0}, matrix.LineMatrix{{0, 0}, {1, 1}}, Mod2BoundaryNodeRule, "F0FFFF102"},
+		{"line in polygon", matrix.LineMatrix{{1, 1}, {2, 2}}, square, Mod2BoundaryNodeRule, "1FF0FF212"},
+		{"line on polygon boundary", matrix.LineMatrix{{0, 0}, {5, 0}}, square, Mod2BoundaryNodeRule, "F1FF0F212"},
+		{"polygons overlapping", square, matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}},
+			Mod2BoundaryNodeRule, "212101212"},
+		{"polygons adjacent", square, matrix.PolygonMatrix{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}},
+			Mod2BoundaryNodeRule, "FF2F11212"},
+		{"polygons equal", square, matrix.PolygonMatrix{{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}}},
+			Mod2BoundaryNodeRule, "2FFF1FFF2"},
+		{"polygon in hole", holed, hole, Mod2BoundaryNodeRule, "FF2F112F2"},
+		{"polygon contains", square, hole, Mod2BoundaryNodeRule, "212FF1FF2"},
+		{"joined mod2", joined, matrix.Matrix{1, 1}, Mod2BoundaryNodeRule, "0F1FF0FF2"},
+		{"joined endpoint", joined, matrix.Matrix{1, 1}, EndPointBoundaryNodeRule, "FF10F0FF2"},
+		{"joined multivalent", joined, matrix.Matrix{1, 1}, MultivalentEndPointBoundaryNodeRule, "FF10FFFF2"},
+		{"joined monovalent", joined, matrix.Matrix{1, 1}, MonovalentEndPointBoundaryNodeRule, "0F1FF0FF2"},
+		{"closed mod2", closed, matrix.Matrix{0, 0}, Mod2BoundaryNodeRule, "0F1FFFFF2"},
+		{"closed endpoint", closed, matrix.Matrix{0, 0}, EndPointBoundaryNodeRule, "FF10FFFF2"},
+		{"line point", matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.Matrix{5, 0}, Mod2BoundaryNodeRule, "0F1FF0FF2"},
+		{"point line", matrix.Matrix{5, 0}, matrix.LineMatrix{{0, 0}, {10, 0}}, Mod2BoundaryNodeRule, "0FFFFF102"},
+		{"point line vertex", matrix.Matrix{5, 0}, matrix.LineMatrix{{0, 0}, {5, 0}, {10, 0}}, Mod2BoundaryNodeRule, "0FFFFF102"},
+		{"line points", matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.Collection{matrix.Matrix{5, 0}, matrix.Matrix{0, 0}},
+			Mod2BoundaryNodeRule, "0F10F0FF2"},
+		{"lines touching endpoint", matrix.LineMatrix{{0, 0}, {1, 1}}, joined, EndPointBoundaryNodeRule, "1FFF0F102"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RelateBoundaryNodeRule(tt.g0, tt.g1, tt.rule)
+			if err != nil {
+				t.Fatalf("RelateBoundaryNodeRule() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RelateBoundaryNodeRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := RelateBoundaryNodeRule(square, hole, BoundaryNodeRule(9)); err == nil {
+		t.Errorf("RelateBoundaryNodeRule() error = nil, want error")
+	}
+}
@@ -0,0 +1,310 @@
+package subdivision
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/operation"
+)
+
+// ConcaveHull computes the concave hull of the points of geometry,
+// the border triangles of Delaunay triangulation are removed while their border edges are longer than maxEdgeLength.
+// The hull is a polygon without the self-touching, the holes are allowed if holesAllowed is true,
+// they are the interior triangles whose edges are longer than maxEdgeLength and do not touch the border.
+// The convex hull is returned if maxEdgeLength is larger than the longest edge,
+// or the points are less than three or collinear.
+func ConcaveHull(geom matrix.Steric, maxEdgeLength float64, holesAllowed bool) matrix.Steric {
+	tri := newHullTriangulation(geom)
+	if tri == nil {
+		return buffer.ConvexHull(geom)
+	}
+	tri.removeTriangles(maxEdgeLength, holesAllowed)
+	return tri.polygons()
+}
+
+// ConcaveHullByLengthRatio computes the concave hull of the points of geometry,
+// the maximum edge length is the lengthRatio between the shortest and the longest edge of Delaunay triangulation,
+// the ratio 1 gives the convex hull and the ratio 0 gives the most concave hull.
+func ConcaveHullByLengthRatio(geom matrix.Steric, lengthRatio float64, holesAllowed bool) matrix.Steric {
+	tri := newHullTriangulation(geom)
+	if tri == nil {
+		return buffer.ConvexHull(geom)
+	}
+	minLength, maxLength := tri.edgeLengthRange()
+	tri.removeTriangles(minLength+lengthRatio*(maxLength-minLength), holesAllowed)
+	return tri.polygons()
+}
+
+// AlphaShape computes the alpha shape of the points of geometry,
+// it is the union of the triangles of Delaunay triangulation whose circumradius is not larger than alpha.
+// The result is a polygon or a collection of polygons which may touch at the points,
+// the holes are removed if holesAllowed is false. The empty polygon is returned if no triangle remains,
+// the convex hull is returned if the points are less than three or collinear.
+func AlphaShape(geom matrix.Steric, alpha float64, holesAllowed bool) matrix.Steric {
+	tri := newHullTriangulation(geom)
+	if tri == nil {
+		return buffer.ConvexHull(geom)
+	}
+	for _, t := range tri.triangles {
+		t.removed = tri.circumradius(t) > alpha
+	}
+	if !holesAllowed {
+		tri.fillHoles()
+	}
+	return tri.polygons()
+}
+
+// hullTriangle is a triangle of hull triangulation,
+// the edge i is from vertices[i] to vertices[(i+1)%3] and adjacent[i] is the triangle on the other side of it.
+type hullTriangle struct {
+	vertices [3]int
+	adjacent [3]int
+	removed  bool
+}
+
+// hullTriangulation is the Delaunay triangulation of points with the adjacency of triangles.
+type hullTriangulation struct {
+	points    []matrix.Matrix
+	triangles []*hullTriangle
+}
+
+// newHullTriangulation returns the triangulation of the unique points of geometry,
+// returns nil if there is no triangle.
+func newHullTriangulation(geom matrix.Steric) *hullTriangulation {
+	points := matrix.TransMatrixes(geom.Filter(matrix.CreateFilterMatrix()))
+	if len(points) < 3 {
+		return nil
+	}
+	tri := &hullTriangulation{}
+	index := map[[2]float64]int{}
+	vertex := func(p matrix.Matrix) int {
+		key := [2]float64{p[0], p[1]}
+		if i, ok := index[key]; ok {
+			return i
+		}
+		index[key] = len(tri.points)
+		tri.points = append(tri.points, p)
+		return len(tri.points) - 1
+	}
+
+	edges := map[[2]int][2]int{}
+	for _, ring := range NewDelaunayTriangulation(points).Triangles() {
+		t := &hullTriangle{adjacent: [3]int{-1, -1, -1}}
+		for i := range t.vertices {
+			t.vertices[i] = vertex(ring[i])
+		}
+		// the degenerate triangle is skipped by its exact orientation, the area depends on the scale of points.
+		p0, p1, p2 := tri.points[t.vertices[0]], tri.points[t.vertices[1]], tri.points[t.vertices[2]]
+		if calc.OrientationIndex(p0[0], p0[1], p1[0], p1[1], p2[0], p2[1]) == calc.Collinear {
+			continue
+		}
+		current := len(tri.triangles)
+		tri.triangles = append(tri.triangles, t)
+		for i := range t.vertices {
+			key := [2]int{t.vertices[(i+1)%3], t.vertices[i]}
+			if other, ok := edges[key]; ok {
+				t.adjacent[i] = other[0]
+				tri.triangles[other[0]].adjacent[other[1]] = current
+				continue
+			}
+			edges[[2]int{t.vertices[i], t.vertices[(i+1)%3]}] = [2]int{current, i}
+		}
+	}
+	if len(tri.triangles) == 0 {
+		return nil
+	}
+	return tri
+}
+
+// edgeLength returns the length of edge i of triangle.
+func (h *hullTriangulation) edgeLength(t *hullTriangle, i int) float64 {
+	p0, p1 := h.points[t.vertices[i]], h.points[t.vertices[(i+1)%3]]
+	return math.Hypot(p1[0]-p0[0], p1[1]-p0[1])
+}
+
+// edgeLengthRange returns the length of the shortest and the longest edge.
+func (h *hullTriangulation) edgeLengthRange() (minLength, maxLength float64) {
+	minLength = math.Inf(1)
+	for _, t := range h.triangles {
+		for i := range t.vertices {
+			length := h.edgeLength(t, i)
+			minLength = math.Min(minLength, length)
+			maxLength = math.Max(maxLength, length)
+		}
+	}
+	return
+}
+
+// circumradius returns the radius of the circumcircle of triangle.
+func (h *hullTriangulation) circumradius(t *hullTriangle) float64 {
+	a, b, c := h.edgeLength(t, 0), h.edgeLength(t, 1), h.edgeLength(t, 2)
+	return a * b * c / (4 * h.area(t))
+}
+
+// area returns the area of triangle.
+func (h *hullTriangulation) area(t *hullTriangle) float64 {
+	p0, p1, p2 := h.points[t.vertices[0]], h.points[t.vertices[1]], h.points[t.vertices[2]]
+	return math.Abs((p1[0]-p0[0])*(p2[1]-p0[1])-(p2[0]-p0[0])*(p1[1]-p0[1])) / 2
+}
+
+// remove removes the triangle, its neighbors get a border edge.
+func (h *hullTriangulation) remove(index int) {
+	t := h.triangles[index]
+	t.removed = true
+	for i, other := range t.adjacent {
+		if other < 0 {
+			continue
+		}
+		neighbor := h.triangles[other]
+		for j := range neighbor.adjacent {
+			if neighbor.adjacent[j] == index {
+				neighbor.adjacent[j] = -1
+			}
+		}
+		t.adjacent[i] = -1
+	}
+}
+
+// removeTriangles removes the triangles in the order of the length of their longest removable edge,
+// until the edges are not longer than maxEdgeLength.
+func (h *hullTriangulation) removeTriangles(maxEdgeLength float64, holesAllowed bool) {
+	border := make([]bool, len(h.points))
+	for _, t := range h.triangles {
+		for i, other := range t.adjacent {
+			if other < 0 {
+				border[t.vertices[i]], border[t.vertices[(i+1)%3]] = true, true
+			}
+		}
+	}
+
+	queue := &hullQueue{}
+	push := func(index int) {
+		if length, ok := h.removableLength(h.triangles[index], border, holesAllowed); ok && length > maxEdgeLength {
+			heap.Push(queue, hullQueueItem{index: index, length: length})
+		}
+	}
+	for i := range h.triangles {
+		push(i)
+	}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(hullQueueItem)
+		t := h.triangles[item.index]
+		if t.removed {
+			continue
+		}
+		// the triangle is pushed again when its neighbors are removed, the old item is skipped.
+		if length, ok := h.removableLength(t, border, holesAllowed); !ok || length != item.length {
+			continue
+		}
+		neighbors := t.adjacent
+		h.remove(item.index)
+		for _, v := range t.vertices {
+			border[v] = true
+		}
+		for _, other := range neighbors {
+			if other >= 0 {
+				push(other)
+			}
+		}
+	}
+}
+
+// removableLength returns the length of the edge by which the triangle is removed,
+// returns false if removing the triangle makes the hull not a polygon without self-touching.
+// The border triangle with one border edge is removable if its opposite vertex is not on the border,
+// the interior triangle is removable as a hole if none of its vertices is on the border.
+func (h *hullTriangulation) removableLength(t *hullTriangle, border []bool, holesAllowed bool) (float64, bool) {
+	borderEdge, count := -1, 0
+	for i, other := range t.adjacent {
+		if other < 0 {
+			borderEdge, count = i, count+1
+		}
+	}
+	switch count {
+	case 0:
+		if !holesAllowed {
+			return 0, false
+		}
+		length := 0.0
+		for i, v := range t.vertices {
+			if border[v] {
+				return 0, false
+			}
+			length = math.Max(length, h.edgeLength(t, i))
+		}
+		return length, true
+	case 1:
+		if border[t.vertices[(borderEdge+2)%3]] {
+			return 0, false
+		}
+		return h.edgeLength(t, borderEdge), true
+	default:
+		return 0, false
+	}
+}
+
+// fillHoles restores the removed triangles which are not connected to the exterior by the edges.
+func (h *hullTriangulation) fillHoles() {
+	exterior := make([]bool, len(h.triangles))
+	stack := []int{}
+	for i, t := range h.triangles {
+		for _, other := range t.adjacent {
+			if t.removed && other < 0 && !exterior[i] {
+				exterior[i] = true
+				stack = append(stack, i)
+			}
+		}
+	}
+	for len(stack) > 0 {
+		t := h.triangles[stack[len(stack)-1]]
+		stack = stack[:len(stack)-1]
+		for _, other := range t.adjacent {
+			if other >= 0 && h.triangles[other].removed && !exterior[other] {
+				exterior[other] = true
+				stack = append(stack, other)
+			}
+		}
+	}
+	for i, t := range h.triangles {
+		t.removed = t.removed && exterior[i]
+	}
+}
+
+// polygons returns the union of the remaining triangles.
+func (h *hullTriangulation) polygons() matrix.Steric {
+	edges := [][2]int{}
+	for _, t := range h.triangles {
+		if t.removed {
+			continue
+		}
+		for i, other := range t.adjacent {
+			if other < 0 || h.triangles[other].removed {
+				edges = append(edges, [2]int{t.vertices[i], t.vertices[(i+1)%3]})
+			}
+		}
+	}
+	return operation.PolygonsFromDirectedEdges(h.points, edges)
+}
+
+// hullQueueItem is the triangle to remove and the length of its removable edge.
+type hullQueueItem struct {
+	index  int
+	length float64
+}
+
+// hullQueue is the priority queue of triangles, the longest edge is first.
+type hullQueue []hullQueueItem
+
+func (q hullQueue) Len() int            { return len(q) }
+func (q hullQueue) Less(i, j int) bool  { return q[i].length > q[j].length }
+func (q hullQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *hullQueue) Push(x interface{}) { *q = append(*q, x.(hullQueueItem)) }
+func (q *hullQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
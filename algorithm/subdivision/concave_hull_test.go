@@ -0,0 +1,118 @@
+package subdivision
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// gridPoints returns the points of grid from 0 to 6 except the points in the bound.
+func gridPoints(except matrix.Bound) matrix.Collection {
+	points := matrix.Collection{}
+	for x := 0.0; x <= 6; x++ {
+		for y := 0.0; y <= 6; y++ {
+			if x >= except[0][0] && x <= except[1][0] && y >= except[0][1] && y <= except[1][1] {
+				continue
+			}
+			points = append(points, matrix.Matrix{x, y})
+		}
+	}
+	return points
+}
+
+func TestConcaveHull(t *testing.T) {
+	notch := gridPoints(matrix.Bound{{2, 3}, {4, 6}})
+	hole := gridPoints(matrix.Bound{{2, 2}, {4, 4}})
+	tests := []struct {
+		name          string
+		geom          matrix.Steric
+		maxEdgeLength float64
+		holesAllowed  bool
+		wantArea      float64
+		wantRings     int
+	}{
+		{"notch", notch, 1.5, false, 21, 1},
+		{"convex", notch, 10, false, 36, 1},
+		{"hole not allowed", hole, 1.5, false, 36, 1},
+		{"hole allowed", hole, 1.5, true, 22, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ConcaveHull(tt.geom, tt.maxEdgeLength, tt.holesAllowed).(matrix.PolygonMatrix)
+			if !ok {
+				t.Fatalf("ConcaveHull() = %v, want polygon", got)
+			}
+			if area := measure.AreaOfPolygon(got); area != tt.wantArea || len(got) != tt.wantRings {
+				t.Errorf("ConcaveHull() area = %v rings = %v, want %v %v", area, len(got), tt.wantArea, tt.wantRings)
+			}
+		})
+	}
+
+	lonLat := matrix.Collection{}
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 20; j++ {
+			lonLat = append(lonLat, matrix.Matrix{116 + float64(i)*1e-5, 39 + float64(j)*1e-5})
+		}
+	}
+	if got := ConcaveHull(lonLat, 1e6, false); !got.Bound()[0].Equals(matrix.Matrix{116, 39}) ||
+		!got.Bound()[1].Equals(matrix.Matrix{116 + 19e-5, 39 + 19e-5}) {
+		t.Errorf("ConcaveHull() of lon/lat grid bound = %v, want %v", got.Bound(), lonLat.Bound())
+	}
+
+	line := matrix.LineMatrix{{0, 0}, {1, 1}, {2, 2}}
+	if got := ConcaveHull(line, 1, false); !reflect.DeepEqual(got, buffer.ConvexHull(line)) {
+		t.Errorf("ConcaveHull() = %v, want %v", got, buffer.ConvexHull(line))
+	}
+}
+
+func TestConcaveHullByLengthRatio(t *testing.T) {
+	notch := gridPoints(matrix.Bound{{2, 3}, {4, 6}})
+	tests := []struct {
+		name     string
+		ratio    float64
+		wantArea float64
+	}{
+		{"most concave", 0, 20},
+		{"convex", 1, 36},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ConcaveHullByLengthRatio(notch, tt.ratio, false).(matrix.PolygonMatrix)
+			if area := measure.AreaOfPolygon(got); area != tt.wantArea {
+				t.Errorf("ConcaveHullByLengthRatio() area = %v, want %v", area, tt.wantArea)
+			}
+		})
+	}
+}
+
+func TestAlphaShape(t *testing.T) {
+	hole := gridPoints(matrix.Bound{{2, 2}, {4, 4}})
+	tests := []struct {
+		name         string
+		alpha        float64
+		holesAllowed bool
+		want         matrix.Steric
+		wantArea     float64
+	}{
+		{"hole allowed", 1, true, nil, 22},
+		{"hole not allowed", 1, false, nil, 36},
+		{"empty", 0.5, true, matrix.PolygonMatrix{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AlphaShape(hole, tt.alpha, tt.holesAllowed)
+			if tt.want != nil {
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("AlphaShape() = %v, want %v", got, tt.want)
+				}
+				return
+			}
+			if area := measure.AreaOfPolygon(got.(matrix.PolygonMatrix)); area != tt.wantArea {
+				t.Errorf("AlphaShape() area = %v, want %v", area, tt.wantArea)
+			}
+		})
+	}
+}
@@ -13,6 +13,11 @@ type DelaunayTriangulation struct {
 	subdivision *quadedge.Subdivision
 }
 
+// NewDelaunayTriangulation returns the Delaunay triangulation of the sites.
+func NewDelaunayTriangulation(sites []matrix.Matrix) *DelaunayTriangulation {
+	return &DelaunayTriangulation{sites: sites}
+}
+
 func (d *DelaunayTriangulation) computeEnvelope() {
 	d.sitesEnv = envelope.Empty()
 	for _, site := range d.sites {
@@ -35,3 +40,8 @@ func (d *DelaunayTriangulation) Subdivision() *quadedge.Subdivision {
 	d.create()
 	return d.subdivision
 }
+
+// Triangles returns the triangles of triangulation as closed rings of coordinates.
+func (d *DelaunayTriangulation) Triangles() []matrix.LineMatrix {
+	return d.Subdivision().GetTriangleCoordinates(false)
+}
@@ -1,6 +1,7 @@
 package subdivision
 
 import (
+	"math"
 	"testing"
 
 	"github.com/spatial-go/geoos/algorithm/matrix"
@@ -31,3 +32,24 @@ func TestDelaunayTriangulation_Subdivision(t *testing.T) {
 		})
 	}
 }
+
+func TestDelaunayTriangulation_TrianglesCocircular(t *testing.T) {
+	// the sites of grid are cocircular by four, which are far from the origin.
+	var sites []matrix.Matrix
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 6; j++ {
+			sites = append(sites, matrix.Matrix{1e6 + float64(i)*0.1, 1e6 + float64(j)*0.1})
+		}
+	}
+	triangles := NewDelaunayTriangulation(sites).Triangles()
+	if len(triangles) != 50 {
+		t.Fatalf("Triangles() = %v triangles, want 50", len(triangles))
+	}
+	area := 0.0
+	for _, tri := range triangles {
+		area += math.Abs((tri[1][0]-tri[0][0])*(tri[2][1]-tri[0][1])-(tri[1][1]-tri[0][1])*(tri[2][0]-tri[0][0])) / 2
+	}
+	if math.Abs(area-0.25) > 1e-6 {
+		t.Errorf("Triangles() area = %v, want 0.25", area)
+	}
+}
@@ -4,15 +4,17 @@ package quadedge
 import (
 	"fmt"
 
+	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
-// IsCCW ...
+// IsCCW returns true if a, b and c are in counter clockwise, which is exact by calc.OrientationIndex.
 func IsCCW(a matrix.Matrix, b matrix.Matrix, c matrix.Matrix) bool {
-	return (b[0]-a[0])*(c[1]-a[1])-(b[1]-a[1])*(c[0]-a[0]) > 0
+	return calc.OrientationIndex(a[0], a[1], b[0], b[1], c[0], c[1]) == calc.CounterClockWise
 }
 
-// IsInCircle ...
+// IsInCircle returns true if v is inside the circle through a, b and c in counter clockwise,
+// which is exact by calc.InCircle.
 func IsInCircle(v matrix.Matrix, a matrix.Matrix, b matrix.Matrix, c matrix.Matrix) bool {
 	return isInCircleRobust(a, b, c, v)
 }
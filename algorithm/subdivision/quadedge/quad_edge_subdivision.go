@@ -294,6 +294,13 @@ func (q *Subdivision) fetchTriangleToVisit(edge *QuadEdge, edgeStack *utils.Stac
 
 }
 
+// GetTriangleCoordinates returns the triangles of subdivision as closed rings of coordinates.
+func (q *Subdivision) GetTriangleCoordinates(includeFrame bool) []matrix.LineMatrix {
+	visitor := &TriangleCoordinatesVisitor{}
+	q.visitTriangles(visitor, includeFrame)
+	return visitor.Triangles
+}
+
 // GetVoronoiCellPolygons ...
 func (q *Subdivision) GetVoronoiCellPolygons() []matrix.PolygonMatrix {
 	q.visitTriangles(&TriangleCircumcentreVisitor{}, true)
@@ -47,3 +47,14 @@ func circumcentrePF(a, b, c matrix.Matrix) matrix.Matrix {
 	)
 	return matrix.Matrix{ccx, ccy}
 }
+
+// TriangleCoordinatesVisitor collects the coordinates of triangles.
+type TriangleCoordinatesVisitor struct {
+	Triangles []matrix.LineMatrix
+}
+
+// Visit ...
+func (t *TriangleCoordinatesVisitor) Visit(triEdges []*QuadEdge) {
+	triangle := matrix.LineMatrix{triEdges[0].Origin(), triEdges[1].Origin(), triEdges[2].Origin(), triEdges[0].Origin()}
+	t.Triangles = append(t.Triangles, triangle)
+}
@@ -98,3 +98,23 @@ func (e *Encoder) ReadGeoJSON(r io.Reader) (*geojson.FeatureCollection, error) {
 	}
 	return nil, nil
 }
+
+// Unmarshal decodes the geobuf data into the slice of struct T, the features are
+// bound by the `geojson:"name"` tags as geojson.Unmarshal does.
+func Unmarshal[T any](data []byte) ([]T, error) {
+	protoGeo := &protogeo.Data{}
+	if err := proto.Unmarshal(data, protoGeo); err != nil {
+		return nil, err
+	}
+	switch gj := decode.Decode(protoGeo).(type) {
+	case *geojson.FeatureCollection:
+		return geojson.Unmarshal[T](gj)
+	case *geojson.Feature:
+		fc := geojson.NewFeatureCollection()
+		return geojson.Unmarshal[T](fc.Append(gj))
+	case *geojson.Geometry:
+		fc := geojson.NewFeatureCollection()
+		return geojson.Unmarshal[T](fc.Append(geojson.NewFeature(*gj)))
+	}
+	return nil, nil
+}
@@ -0,0 +1,40 @@
+package geobuf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spatial-go/geoos/geoencoding/geojson"
+	"github.com/spatial-go/geoos/space"
+)
+
+func TestUnmarshal(t *testing.T) {
+	type road struct {
+		Name  string  `geojson:"name"`
+		Lanes int     `geojson:"lanes"`
+		Width float64 `geojson:"width"`
+	}
+
+	fc := geojson.NewFeatureCollection()
+	for _, v := range []road{{"a", 2, 3.5}, {"b", -1, 7}} {
+		f, err := geojson.NewFeatureFromStruct(space.LineString{{0, 0}, {1, 1}}, v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fc.Append(f)
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := &Encoder{}
+	if err := encoder.WriteGeoJSON(buf, fc); err != nil {
+		t.Fatal(err)
+	}
+
+	roads, err := Unmarshal[road](buf.Bytes())
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(roads) != 2 || roads[0] != (road{"a", 2, 3.5}) || roads[1] != (road{"b", -1, 7}) {
+		t.Errorf("Unmarshal() got = %+v", roads)
+	}
+}
@@ -65,3 +65,49 @@ func (k *keyStore) sort() {
 		k.sorted = true
 	}
 }
+
+type orderedKeyStore struct {
+	keys    []string
+	indexes map[string]int
+}
+
+// NewOrderedKeyStore returns a KeyStore that keeps the keys in insertion order,
+// the index of a key never changes once it is added, so the features can be encoded
+// before all the keys are known. IndexOf returns -1 if the key is not added.
+func NewOrderedKeyStore(keys []string) KeyStore {
+	k := &orderedKeyStore{}
+	k.Reset()
+	for _, key := range keys {
+		k.Add(key)
+	}
+	return k
+}
+
+// Keys ...
+func (k *orderedKeyStore) Keys() []string {
+	return k.keys
+}
+
+// IndexOf ...
+func (k *orderedKeyStore) IndexOf(key string) int {
+	if idx, ok := k.indexes[key]; ok {
+		return idx
+	}
+	return -1
+}
+
+// Add ...
+func (k *orderedKeyStore) Add(key string) int {
+	if idx, ok := k.indexes[key]; ok {
+		return idx
+	}
+	k.keys = append(k.keys, key)
+	k.indexes[key] = len(k.keys) - 1
+	return len(k.keys) - 1
+}
+
+// Reset ...
+func (k *orderedKeyStore) Reset() {
+	k.keys = []string{}
+	k.indexes = map[string]int{}
+}
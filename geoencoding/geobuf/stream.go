@@ -0,0 +1,356 @@
+package geobuf
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/spatial-go/geoos/geoencoding/geobuf/decode"
+	"github.com/spatial-go/geoos/geoencoding/geobuf/encode"
+	"github.com/spatial-go/geoos/geoencoding/geobuf/protogeo"
+	"github.com/spatial-go/geoos/geoencoding/geojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// field numbers of the geobuf Data message.
+const (
+	fieldKeys              protowire.Number = 1
+	fieldDimensions        protowire.Number = 2
+	fieldPrecision         protowire.Number = 3
+	fieldFeatureCollection protowire.Number = 4
+	fieldFeature           protowire.Number = 5
+	fieldGeometry          protowire.Number = 6
+
+	// fieldFeatures is the field number of features in the FeatureCollection message.
+	fieldFeatures protowire.Number = 1
+)
+
+// defaultStreamPrecision is the number of digits after decimal point used by FeatureWriter.
+const defaultStreamPrecision = 6
+
+// ErrWriterClosed will be returned if the features are written after the writer closed.
+var ErrWriterClosed = errors.New("geobuf: write to closed feature writer")
+
+// ErrInvalidWireType will be returned if the geobuf data can not be parsed.
+var ErrInvalidWireType = errors.New("geobuf: invalid wire type")
+
+// FeatureWriter writes the features of a feature collection into the geobuf stream one by one,
+// no feature is kept in memory after it is written.
+//
+// The header (dimensions, precision and the keys known up front) is written before the first feature.
+// A key first used by a feature is written just before the feature, so the stream is decoded by any
+// geobuf reader as a single feature collection: protobuf concatenates the repeated keys and
+// merges the feature collection fields.
+type FeatureWriter struct {
+	w             *bufio.Writer
+	cfg           *encode.EncodingConfig
+	headerWritten bool
+	closed        bool
+	buf           []byte
+}
+
+// NewFeatureWriter returns a FeatureWriter that writes to w.
+// The options encode.WithPrecision, encode.WithDimension and encode.WithKeyStore decide
+// the header, the precision is 6 digits after decimal point by default.
+func NewFeatureWriter(w io.Writer, opts ...encode.EncodingOption) *FeatureWriter {
+	cfg := &encode.EncodingConfig{
+		Dimension: 2,
+		Precision: uint(protogeo.DecodePrecision(defaultStreamPrecision)),
+		Keys:      protogeo.NewOrderedKeyStore(nil),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	// the indexes of keys must not change after the features are written.
+	cfg.Keys = protogeo.NewOrderedKeyStore(cfg.Keys.Keys())
+
+	return &FeatureWriter{w: bufio.NewWriter(w), cfg: cfg}
+}
+
+// WriteFeature writes the feature into the stream.
+func (fw *FeatureWriter) WriteFeature(feature *geojson.Feature) error {
+	if fw.closed {
+		return ErrWriterClosed
+	}
+	if err := fw.writeHeader(); err != nil {
+		return err
+	}
+
+	for key := range feature.Properties {
+		if fw.cfg.Keys.IndexOf(key) < 0 {
+			fw.cfg.Keys.Add(key)
+			fw.buf = protowire.AppendTag(fw.buf[:0], fieldKeys, protowire.BytesType)
+			fw.buf = protowire.AppendString(fw.buf, key)
+			if _, err := fw.w.Write(fw.buf); err != nil {
+				return err
+			}
+		}
+	}
+
+	protoFeature, err := encode.Feature(feature, fw.cfg)
+	if err != nil {
+		return err
+	}
+	collection, err := proto.Marshal(&protogeo.Data_FeatureCollection{
+		Features: []*protogeo.Data_Feature{protoFeature},
+	})
+	if err != nil {
+		return err
+	}
+	return fw.writeField(fieldFeatureCollection, collection)
+}
+
+// WriteFeatureCollection writes all the features of the collection into the stream.
+func (fw *FeatureWriter) WriteFeatureCollection(fc *geojson.FeatureCollection) error {
+	for _, f := range fc.Features {
+		if err := fw.WriteFeature(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes the buffered data to the underlying writer.
+func (fw *FeatureWriter) Flush() error {
+	return fw.w.Flush()
+}
+
+// Close writes the header if no feature is written and flushes the writer.
+// It does not close the underlying writer.
+func (fw *FeatureWriter) Close() error {
+	if fw.closed {
+		return nil
+	}
+	if err := fw.writeHeader(); err != nil {
+		return err
+	}
+	fw.closed = true
+	return fw.w.Flush()
+}
+
+func (fw *FeatureWriter) writeHeader() error {
+	if fw.headerWritten {
+		return nil
+	}
+	fw.headerWritten = true
+
+	fw.buf = fw.buf[:0]
+	for _, key := range fw.cfg.Keys.Keys() {
+		fw.buf = protowire.AppendTag(fw.buf, fieldKeys, protowire.BytesType)
+		fw.buf = protowire.AppendString(fw.buf, key)
+	}
+	fw.buf = protowire.AppendTag(fw.buf, fieldDimensions, protowire.VarintType)
+	fw.buf = protowire.AppendVarint(fw.buf, uint64(fw.cfg.Dimension))
+	fw.buf = protowire.AppendTag(fw.buf, fieldPrecision, protowire.VarintType)
+	fw.buf = protowire.AppendVarint(fw.buf, uint64(protogeo.EncodePrecision(fw.cfg.Precision)))
+	if _, err := fw.w.Write(fw.buf); err != nil {
+		return err
+	}
+	// an empty feature collection, the stream without feature is decoded as an empty collection.
+	return fw.writeField(fieldFeatureCollection, nil)
+}
+
+func (fw *FeatureWriter) writeField(num protowire.Number, value []byte) error {
+	fw.buf = protowire.AppendTag(fw.buf[:0], num, protowire.BytesType)
+	fw.buf = protowire.AppendVarint(fw.buf, uint64(len(value)))
+	if _, err := fw.w.Write(fw.buf); err != nil {
+		return err
+	}
+	_, err := fw.w.Write(value)
+	return err
+}
+
+// FeatureReader reads the features from the geobuf stream one by one,
+// without reading the whole message into memory.
+// It reads the data written by FeatureWriter and by Encoder,
+// the keys used by a feature must precede it.
+type FeatureReader struct {
+	r      *countReader
+	header *protogeo.Data
+	// fcEnd is the offset of the end of the feature collection field being read, -1 if none.
+	fcEnd int64
+}
+
+// NewFeatureReader returns a FeatureReader that reads from r.
+func NewFeatureReader(r io.Reader) *FeatureReader {
+	return &FeatureReader{
+		r:      &countReader{r: bufio.NewReader(r)},
+		header: &protogeo.Data{Dimensions: 2},
+		fcEnd:  -1,
+	}
+}
+
+// Next returns the next feature of the stream, returns io.EOF if there are no more features.
+func (fr *FeatureReader) Next() (*geojson.Feature, error) {
+	for {
+		if fr.fcEnd >= 0 {
+			if fr.r.n >= fr.fcEnd {
+				fr.fcEnd = -1
+				continue
+			}
+			num, typ, err := fr.readTag()
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			if num == fieldFeatures && typ == protowire.BytesType {
+				return fr.readFeature()
+			}
+			if err := fr.skip(typ); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		num, typ, err := fr.readTag()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case num == fieldKeys && typ == protowire.BytesType:
+			key, err := fr.readBytes()
+			if err != nil {
+				return nil, err
+			}
+			fr.header.Keys = append(fr.header.Keys, string(key))
+		case num == fieldDimensions && typ == protowire.VarintType:
+			v, err := binary.ReadUvarint(fr.r)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			fr.header.Dimensions = uint32(v)
+		case num == fieldPrecision && typ == protowire.VarintType:
+			v, err := binary.ReadUvarint(fr.r)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			fr.header.Precision = uint32(v)
+		case num == fieldFeatureCollection && typ == protowire.BytesType:
+			length, err := binary.ReadUvarint(fr.r)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			fr.fcEnd = fr.r.n + int64(length)
+		case num == fieldFeature && typ == protowire.BytesType:
+			return fr.readFeature()
+		case num == fieldGeometry && typ == protowire.BytesType:
+			b, err := fr.readBytes()
+			if err != nil {
+				return nil, err
+			}
+			geometry := &protogeo.Data_Geometry{}
+			if err := proto.Unmarshal(b, geometry); err != nil {
+				return nil, err
+			}
+			return geojson.NewFeature(*decode.Geometry(geometry, fr.header.Precision, fr.header.Dimensions)), nil
+		default:
+			if err := fr.skip(typ); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// ReadAll reads all the remaining features into a feature collection.
+func (fr *FeatureReader) ReadAll() (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	for {
+		f, err := fr.Next()
+		if err == io.EOF {
+			return fc, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		fc.Append(f)
+	}
+}
+
+func (fr *FeatureReader) readFeature() (*geojson.Feature, error) {
+	b, err := fr.readBytes()
+	if err != nil {
+		return nil, err
+	}
+	feature := &protogeo.Data_Feature{}
+	if err := proto.Unmarshal(b, feature); err != nil {
+		return nil, err
+	}
+	return decode.Feature(fr.header, feature), nil
+}
+
+func (fr *FeatureReader) readTag() (protowire.Number, protowire.Type, error) {
+	v, err := binary.ReadUvarint(fr.r)
+	if err != nil {
+		return 0, 0, err
+	}
+	num, typ := protowire.DecodeTag(v)
+	if !num.IsValid() {
+		return 0, 0, ErrInvalidWireType
+	}
+	return num, typ, nil
+}
+
+func (fr *FeatureReader) readBytes() ([]byte, error) {
+	length, err := binary.ReadUvarint(fr.r)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(fr.r, b); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return b, nil
+}
+
+func (fr *FeatureReader) skip(typ protowire.Type) error {
+	var n int64
+	switch typ {
+	case protowire.VarintType:
+		_, err := binary.ReadUvarint(fr.r)
+		return unexpectedEOF(err)
+	case protowire.Fixed32Type:
+		n = 4
+	case protowire.Fixed64Type:
+		n = 8
+	case protowire.BytesType:
+		length, err := binary.ReadUvarint(fr.r)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		n = int64(length)
+	default:
+		return ErrInvalidWireType
+	}
+	_, err := io.CopyN(io.Discard, fr.r, n)
+	return unexpectedEOF(err)
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// countReader counts the bytes read from the reader.
+type countReader struct {
+	r *bufio.Reader
+	n int64
+}
+
+// Read ...
+func (c *countReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// ReadByte ...
+func (c *countReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.n++
+	}
+	return b, err
+}
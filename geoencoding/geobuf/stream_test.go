@@ -0,0 +1,121 @@
+package geobuf
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/spatial-go/geoos/geoencoding/geobuf/encode"
+	"github.com/spatial-go/geoos/geoencoding/geobuf/protogeo"
+	"github.com/spatial-go/geoos/geoencoding/geojson"
+	"github.com/spatial-go/geoos/space"
+)
+
+type streamTestProperties struct {
+	Index int  `geojson:"index"`
+	Odd   bool `geojson:"odd"`
+}
+
+func streamTestCollection() *geojson.FeatureCollection {
+	fc := geojson.NewFeatureCollection()
+	for i := 0; i < 5; i++ {
+		f := geojson.NewFeature(*geojson.NewGeometry(space.LineString{{float64(i), 0.5}, {116.123456, 39.654321}}))
+		f.ID = "f" + string(rune('a'+i))
+		f.Properties["index"] = i
+		if i%2 == 1 {
+			f.Properties["odd"] = true
+		}
+		fc.Append(f)
+	}
+	return fc
+}
+
+func TestFeatureWriter(t *testing.T) {
+	fc := streamTestCollection()
+
+	buf := &bytes.Buffer{}
+	writer := NewFeatureWriter(buf, encode.WithKeyStore(protogeo.NewKeyStoreWithKeys([]string{"index"})))
+	if err := writer.WriteFeatureCollection(fc); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	encoder := &Encoder{}
+	got, err := encoder.ReadGeoJSON(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Features) != len(fc.Features) {
+		t.Fatalf("ReadGeoJSON() got %d features, want %d", len(got.Features), len(fc.Features))
+	}
+	for i, f := range got.Features {
+		props := streamTestProperties{}
+		if err := f.DecodeProperties(&props); err != nil {
+			t.Fatal(err)
+		}
+		if f.ID != fc.Features[i].ID || props.Index != i || props.Odd != (i%2 == 1) {
+			t.Errorf("ReadGeoJSON() got = %v", f)
+		}
+		if !f.Geometry.Geometry().Equals(fc.Features[i].Geometry.Geometry()) {
+			t.Errorf("ReadGeoJSON() got = %v, want %v", f.Geometry.Geometry(), fc.Features[i].Geometry.Geometry())
+		}
+	}
+}
+
+func TestFeatureReader(t *testing.T) {
+	fc := streamTestCollection()
+
+	streamBuf := &bytes.Buffer{}
+	writer := NewFeatureWriter(streamBuf)
+	_ = writer.WriteFeatureCollection(fc)
+	_ = writer.Close()
+
+	encodeBuf := &bytes.Buffer{}
+	_ = (&Encoder{}).WriteGeoJSON(encodeBuf, fc)
+
+	emptyBuf := &bytes.Buffer{}
+	_ = NewFeatureWriter(emptyBuf).Close()
+
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{name: "stream", data: streamBuf.Bytes(), want: len(fc.Features)},
+		{name: "encoder", data: encodeBuf.Bytes(), want: len(fc.Features)},
+		{name: "empty", data: emptyBuf.Bytes(), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := NewFeatureReader(bytes.NewReader(tt.data))
+			count := 0
+			for {
+				f, err := reader.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("Next() error = %v", err)
+				}
+				props := streamTestProperties{}
+				if err := f.DecodeProperties(&props); err != nil || props.Index != count {
+					t.Errorf("Next() got = %v", f.Properties)
+				}
+				if !f.Geometry.Geometry().Equals(fc.Features[count].Geometry.Geometry()) {
+					t.Errorf("Next() got = %v", f.Geometry.Geometry())
+				}
+				count++
+			}
+			if count != tt.want {
+				t.Errorf("Next() got %d features, want %d", count, tt.want)
+			}
+		})
+	}
+
+	reader := NewFeatureReader(bytes.NewReader(streamBuf.Bytes()[:streamBuf.Len()-3]))
+	if _, err := reader.ReadAll(); err != io.ErrUnexpectedEOF {
+		t.Errorf("ReadAll() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
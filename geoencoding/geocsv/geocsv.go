@@ -153,3 +153,10 @@ func (gc *GeoCSV) ToGeoJSON() (features *geojson.FeatureCollection) {
 	}
 	return
 }
+
+// Unmarshal decodes the rows of csv into the slice of struct T, the cells are
+// bound by the `geojson:"name"` tags as geojson.Unmarshal does, the text is parsed for
+// the numeric and bool fields.
+func Unmarshal[T any](gc *GeoCSV) ([]T, error) {
+	return geojson.Unmarshal[T](gc.ToGeoJSON())
+}
@@ -96,3 +96,26 @@ func TestGeoCSV_Test2(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshal(t *testing.T) {
+	type way struct {
+		WayID int            `geojson:"way_id"`
+		PtID  uint8          `geojson:"pt_id"`
+		X     float64        `geojson:"x"`
+		Point space.Geometry `geojson:",geometry"`
+	}
+	gc, err := Read("./test1.csv", Options{XField: "x", YField: "y"})
+	if err != nil {
+		t.Fatalf("GeoCSV.Read() error = %v", err)
+	}
+	ways, err := Unmarshal[way](gc)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(ways) != 4 {
+		t.Fatalf("length of ways is wrong")
+	}
+	if ways[2].WayID != 2 || ways[2].PtID != 1 || ways[2].X != -2 || !ways[2].Point.Equals(space.Point{-2, 49}) {
+		t.Errorf("Unmarshal() got = %+v", ways[2])
+	}
+}
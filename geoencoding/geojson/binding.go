@@ -0,0 +1,362 @@
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+// ErrNotStructPointer will be returned if the binding target is not a pointer to struct.
+var ErrNotStructPointer = errors.New("geojson: binding target should be a non-nil pointer to struct")
+
+// ErrNotStruct will be returned if the binding source is not a struct or pointer to struct.
+var ErrNotStruct = errors.New("geojson: binding source should be a struct or pointer to struct")
+
+// tag options of the binding field.
+const (
+	tagName      = "geojson"
+	tagGeometry  = "geometry"
+	tagID        = "id"
+	tagOmitEmpty = "omitempty"
+)
+
+// bindingField describes a struct field bound to a feature.
+type bindingField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+	geometry  bool
+	id        bool
+}
+
+var bindingFieldsCache sync.Map
+
+var geometryType = reflect.TypeOf((*space.Geometry)(nil)).Elem()
+
+// bindingFields returns the bound fields of the struct type, the fields are described by tags:
+//
+//	Name     string         `geojson:"name"`           // property "name"
+//	Count    int            `geojson:"count,omitempty"` // property "count", omitted if zero
+//	Ignored  string         `geojson:"-"`              // not bound
+//	Geometry space.Geometry `geojson:",geometry"`      // the geometry of feature
+//	ID       string         `geojson:",id"`            // the id of feature
+//
+// Exported fields without tag are bound to the property named as the field,
+// the fields of the embedded structs are bound as the fields of the outer struct.
+func bindingFields(t reflect.Type) []bindingField {
+	if fields, ok := bindingFieldsCache.Load(t); ok {
+		return fields.([]bindingField)
+	}
+	fields := typeBindingFields(t, nil)
+	bindingFieldsCache.Store(t, fields)
+	return fields
+}
+
+func typeBindingFields(t reflect.Type, index []int) []bindingField {
+	fields := []bindingField{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, hasTag := sf.Tag.Lookup(tagName)
+		if tag == "-" {
+			continue
+		}
+		fieldIndex := append(append([]int{}, index...), i)
+
+		if sf.Anonymous && !hasTag {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				if !sf.IsExported() {
+					// the unexported embedded pointer cannot be allocated.
+					continue
+				}
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				fields = append(fields, typeBindingFields(ft, fieldIndex)...)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+
+		field := bindingField{name: sf.Name, index: fieldIndex}
+		parts := strings.Split(tag, ",")
+		if parts[0] != "" {
+			field.name = parts[0]
+		}
+		for _, opt := range parts[1:] {
+			switch opt {
+			case tagOmitEmpty:
+				field.omitEmpty = true
+			case tagGeometry:
+				field.geometry = true
+			case tagID:
+				field.id = true
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// fieldByIndex returns the field of the struct value, the nil embedded pointers are allocated if alloc.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// DecodeProperties decodes the properties of the feature into the struct pointed to by v.
+// The fields are bound by the `geojson:"name"` tags, the values are converted
+// as the Must* helpers of Properties do, e.g. a float64 property can be bound to an int field.
+// The field tagged `geojson:",geometry"` is set to the geometry of feature
+// and the field tagged `geojson:",id"` is set to the id of feature.
+func (f *Feature) DecodeProperties(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrNotStructPointer
+	}
+	rv = rv.Elem()
+
+	for _, field := range bindingFields(rv.Type()) {
+		var value interface{}
+		switch {
+		case field.geometry:
+			geometry := f.Geometry.Geometry()
+			if geometry == nil || geometry.IsEmpty() {
+				continue
+			}
+			value = geometry
+		case field.id:
+			value = f.ID
+		default:
+			value = f.Properties[field.name]
+		}
+		if value == nil {
+			continue
+		}
+
+		fv, _ := fieldByIndex(rv, field.index, true)
+		if err := bindValue(fv, value); err != nil {
+			return fmt.Errorf("geojson: cannot bind %q: %w", field.name, err)
+		}
+	}
+	return nil
+}
+
+// NewFeatureFromStruct creates a feature by the geometry, the properties are
+// encoded from the fields of the struct v that are bound by the `geojson:"name"` tags.
+// If geometry is nil, the field tagged `geojson:",geometry"` is used as geometry.
+func NewFeatureFromStruct(geometry space.Geometry, v interface{}) (*Feature, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, ErrNotStruct
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, ErrNotStruct
+	}
+
+	properties := Properties{}
+	var id interface{}
+	for _, field := range bindingFields(rv.Type()) {
+		fv, ok := fieldByIndex(rv, field.index, false)
+		if !ok {
+			continue
+		}
+		if field.omitEmpty && fv.IsZero() {
+			continue
+		}
+		switch {
+		case field.geometry:
+			if geometry == nil && fv.Type().Implements(geometryType) && !isNilValue(fv) {
+				geometry = fv.Interface().(space.Geometry)
+			}
+		case field.id:
+			if !isNilValue(fv) {
+				id = fv.Interface()
+			}
+		default:
+			if isNilValue(fv) {
+				properties[field.name] = nil
+			} else {
+				properties[field.name] = reflect.Indirect(fv).Interface()
+			}
+		}
+	}
+
+	var feature *Feature
+	if geometry == nil {
+		feature = NewFeature(Geometry{})
+	} else {
+		feature = NewFeature(*NewGeometry(geometry))
+	}
+	feature.ID = id
+	feature.Properties = properties
+	return feature, nil
+}
+
+// Unmarshal decodes the features of the collection into the slice of struct T,
+// every feature is decoded by DecodeProperties.
+func Unmarshal[T any](fc *FeatureCollection) ([]T, error) {
+	if fc == nil {
+		return nil, nil
+	}
+	result := make([]T, len(fc.Features))
+	for i, f := range fc.Features {
+		if err := f.DecodeProperties(&result[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+// bindValue sets the value to the field.
+func bindValue(fv reflect.Value, value interface{}) error {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		return bindValue(fv.Elem(), value)
+	}
+
+	vv := reflect.ValueOf(value)
+	if vv.Type().AssignableTo(fv.Type()) {
+		fv.Set(vv)
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.Bool:
+		if s, ok := value.(string); ok {
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return err
+			}
+			fv.SetBool(b)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i, ok := toInt64(value); ok {
+			if fv.OverflowInt(i) {
+				return fmt.Errorf("value %v overflows %v", value, fv.Type())
+			}
+			fv.SetInt(i)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if u, ok := toUint64(value); ok {
+			if fv.OverflowUint(u) {
+				return fmt.Errorf("value %v overflows %v", value, fv.Type())
+			}
+			fv.SetUint(u)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if f, ok := toFloat64(value); ok {
+			fv.SetFloat(f)
+			return nil
+		}
+	case reflect.String:
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+		// the json values, e.g. the geobuf json values are strings.
+		data, ok := value.(string)
+		if !ok {
+			b, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			data = string(b)
+		}
+		return json.Unmarshal([]byte(data), fv.Addr().Interface())
+	}
+
+	if vv.Type().ConvertibleTo(fv.Type()) && vv.Kind() == fv.Kind() {
+		fv.Set(vv.Convert(fv.Type()))
+		return nil
+	}
+	return fmt.Errorf("not a %v, but a %T: %v", fv.Type(), value, value)
+}
+
+// toInt64 returns the value as int64, the float values are truncated like MustInt,
+// the strings are parsed for the text sources such as GeoCSV.
+func toInt64(value interface{}) (int64, bool) {
+	vv := reflect.ValueOf(value)
+	switch vv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return vv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if u := vv.Uint(); u <= 1<<63-1 {
+			return int64(u), true
+		}
+	case reflect.Float32, reflect.Float64:
+		return int64(vv.Float()), true
+	case reflect.String:
+		if i, err := strconv.ParseInt(vv.String(), 10, 64); err == nil {
+			return i, true
+		}
+		if f, err := strconv.ParseFloat(vv.String(), 64); err == nil {
+			return int64(f), true
+		}
+	}
+	return 0, false
+}
+
+// toUint64 returns the value as uint64, the negative values are not converted.
+func toUint64(value interface{}) (uint64, bool) {
+	vv := reflect.ValueOf(value)
+	switch vv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return vv.Uint(), true
+	}
+	if i, ok := toInt64(value); ok && i >= 0 {
+		return uint64(i), true
+	}
+	return 0, false
+}
+
+// toFloat64 returns the value as float64,
+// the strings are parsed for the text sources such as GeoCSV.
+func toFloat64(value interface{}) (float64, bool) {
+	vv := reflect.ValueOf(value)
+	switch vv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(vv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(vv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return vv.Float(), true
+	case reflect.String:
+		if f, err := strconv.ParseFloat(vv.String(), 64); err == nil {
+			return f, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,99 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+type bindingBase struct {
+	Name string `geojson:"name"`
+}
+
+type bindingTest struct {
+	bindingBase
+	ID       string         `geojson:",id"`
+	Geometry space.Geometry `geojson:",geometry"`
+	Count    int            `geojson:"count"`
+	Ratio    float64        `geojson:"ratio,omitempty"`
+	Valid    *bool          `geojson:"valid"`
+	Tags     []string       `geojson:"tags"`
+	Ignored  string         `geojson:"-"`
+}
+
+func TestFeature_DecodeProperties(t *testing.T) {
+	rawJSON := `{"type":"Feature","id":"a1","geometry":{"type":"Point","coordinates":[1,2]},
+		"properties":{"name":"road","count":3.0,"ratio":1,"valid":true,"tags":["x","y"],"Ignored":"z"}}`
+	f, err := UnmarshalFeature([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	v := bindingTest{}
+	if err := f.DecodeProperties(&v); err != nil {
+		t.Fatalf("decode properties error: %v", err)
+	}
+	if v.Name != "road" || v.ID != "a1" || v.Count != 3 || v.Ratio != 1 ||
+		v.Valid == nil || !*v.Valid || len(v.Tags) != 2 || v.Ignored != "" {
+		t.Errorf("incorrect struct: %+v", v)
+	}
+	if !v.Geometry.Equals(space.Point{1, 2}) {
+		t.Errorf("incorrect geometry: %v", v.Geometry)
+	}
+
+	f.Properties["count"] = "text"
+	if err := f.DecodeProperties(&v); err == nil {
+		t.Errorf("should return error if property is not a number")
+	}
+	if err := f.DecodeProperties(v); err != ErrNotStructPointer {
+		t.Errorf("should return error if target is not a pointer")
+	}
+}
+
+func TestNewFeatureFromStruct(t *testing.T) {
+	v := bindingTest{bindingBase: bindingBase{Name: "road"}, ID: "a1", Count: 2,
+		Geometry: space.LineString{{0, 0}, {1, 1}}}
+	f, err := NewFeatureFromStruct(nil, &v)
+	if err != nil {
+		t.Fatalf("new feature error: %v", err)
+	}
+	if f.ID != "a1" || f.Properties.MustString("name") != "road" || f.Properties.MustInt("count") != 2 {
+		t.Errorf("incorrect feature: %+v", f)
+	}
+	if _, ok := f.Properties["ratio"]; ok {
+		t.Errorf("should omit empty property")
+	}
+	if _, ok := f.Properties["Ignored"]; ok {
+		t.Errorf("should ignore property")
+	}
+	if !f.Geometry.Geometry().Equals(space.LineString{{0, 0}, {1, 1}}) {
+		t.Errorf("incorrect geometry: %v", f.Geometry.Geometry())
+	}
+
+	f, _ = NewFeatureFromStruct(space.Point{1, 1}, v)
+	if !f.Geometry.Geometry().Equals(space.Point{1, 1}) {
+		t.Errorf("incorrect geometry: %v", f.Geometry.Geometry())
+	}
+
+	if _, err := NewFeatureFromStruct(nil, 1); err != ErrNotStruct {
+		t.Errorf("should return error if source is not a struct")
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	fc := NewFeatureCollection()
+	for i, name := range []string{"a", "b"} {
+		f := NewFeature(*NewGeometry(space.Point{float64(i), 0}))
+		f.Properties["name"] = name
+		f.Properties["count"] = uint(i)
+		fc.Append(f)
+	}
+
+	result, err := Unmarshal[bindingTest](fc)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if len(result) != 2 || result[1].Name != "b" || result[1].Count != 1 {
+		t.Errorf("incorrect result: %+v", result)
+	}
+}
@@ -12,8 +12,15 @@ type Feature struct {
 	BBox       BBox        `json:"bbox,omitempty"`
 	Geometry   Geometry    `json:"geometry"`
 	Properties Properties  `json:"properties"`
+
+	// ForeignMembers are the members that not defined by the GeoJSON specification,
+	// they are preserved when unmarshalling and marshalling.
+	ForeignMembers map[string]interface{} `json:"-"`
 }
 
+// featureMembers are the members defined for GeoJSON feature.
+var featureMembers = []string{"id", "type", "bbox", "geometry", "properties"}
+
 // NewFeature creates and initializes a GeoJSON feature given the required attributes.
 func NewFeature(geometry Geometry) *Feature {
 	return &Feature{
@@ -27,6 +34,16 @@ func NewFeature(geometry Geometry) *Feature {
 // It will handle the encoding of all the child geometries.
 // Alternately one can call json.Marshal(f) directly for the same result.
 func (f Feature) MarshalJSON() ([]byte, error) {
+	return f.marshal(nil)
+}
+
+// MarshalWithOptions converts the feature object into the proper JSON with the options,
+// e.g. the precision of coordinates, the right-hand rule winding of rings and the bbox computation.
+func (f Feature) MarshalWithOptions(opts ...MarshalOption) ([]byte, error) {
+	return f.marshal(newMarshalConfig(opts...))
+}
+
+func (f Feature) marshal(cfg *MarshalConfig) ([]byte, error) {
 	jf := &jsonFeature{
 		ID:         f.ID,
 		Type:       "Feature",
@@ -35,11 +52,27 @@ func (f Feature) MarshalJSON() ([]byte, error) {
 		Geometry:   NewGeometry(f.Geometry.Geometry()),
 	}
 
+	if cfg != nil {
+		if cfg.BBox && !jf.BBox.Valid() {
+			if bound, ok := geometryBound(jf.Geometry); ok {
+				jf.BBox = NewBBox(bound)
+			}
+		}
+		if jf.BBox != nil {
+			jf.BBox = cfg.bbox(jf.BBox)
+		}
+		jf.Geometry = jf.Geometry.applyConfig(cfg)
+	}
+
 	if len(jf.Properties) == 0 {
 		jf.Properties = nil
 	}
 
-	return json.Marshal(jf)
+	data, err := json.Marshal(jf)
+	if err != nil {
+		return nil, err
+	}
+	return marshalForeignMembers(data, f.ForeignMembers, featureMembers)
 }
 
 // UnmarshalFeature decodes the data into a GeoJSON feature.
@@ -71,12 +104,18 @@ func (f *Feature) UnmarshalJSON(data []byte) error {
 		return ErrInvalidGeometry
 	}
 
+	members, err := unmarshalForeignMembers(data, featureMembers)
+	if err != nil {
+		return err
+	}
+
 	*f = Feature{
-		ID:         jf.ID,
-		Type:       jf.Type,
-		Properties: jf.Properties,
-		BBox:       jf.BBox,
-		Geometry:   *jf.Geometry,
+		ID:             jf.ID,
+		Type:           jf.Type,
+		Properties:     jf.Properties,
+		BBox:           jf.BBox,
+		Geometry:       *jf.Geometry,
+		ForeignMembers: members,
 	}
 
 	return nil
@@ -17,8 +17,15 @@ type FeatureCollection struct {
 	Type     string     `json:"type"`
 	BBox     BBox       `json:"bbox,omitempty"`
 	Features []*Feature `json:"features"`
+
+	// ForeignMembers are the members that not defined by the GeoJSON specification,
+	// they are preserved when unmarshalling and marshalling.
+	ForeignMembers map[string]interface{} `json:"-"`
 }
 
+// featureCollectionMembers are the members defined for GeoJSON feature collection.
+var featureCollectionMembers = []string{"type", "bbox", "features"}
+
 // NewFeatureCollection creates and initializes a new feature collection.
 func NewFeatureCollection() *FeatureCollection {
 	return &FeatureCollection{
@@ -48,7 +55,81 @@ func (fc FeatureCollection) MarshalJSON() ([]byte, error) {
 	if c.Features == nil {
 		c.Features = []*Feature{}
 	}
-	return json.Marshal(c)
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return marshalForeignMembers(data, fc.ForeignMembers, featureCollectionMembers)
+}
+
+// MarshalWithOptions converts the feature collection object into the proper JSON with the options,
+// e.g. the precision of coordinates, the right-hand rule winding of rings and the bbox computation.
+// The options are applied to all the child features.
+func (fc FeatureCollection) MarshalWithOptions(opts ...MarshalOption) ([]byte, error) {
+	cfg := newMarshalConfig(opts...)
+
+	c := struct {
+		Type     string            `json:"type"`
+		BBox     BBox              `json:"bbox,omitempty"`
+		Features []json.RawMessage `json:"features"`
+	}{
+		Type:     featureCollection,
+		BBox:     fc.BBox,
+		Features: make([]json.RawMessage, 0, len(fc.Features)),
+	}
+
+	var bound space.Bound
+	hasBound := false
+	for _, f := range fc.Features {
+		data, err := f.marshal(cfg)
+		if err != nil {
+			return nil, err
+		}
+		c.Features = append(c.Features, data)
+
+		if cfg.BBox && !c.BBox.Valid() {
+			geometry := f.Geometry
+			if b, ok := geometryBound(&geometry); ok {
+				if hasBound {
+					bound = extendBound(bound, b)
+				} else {
+					bound, hasBound = b, true
+				}
+			}
+		}
+	}
+	if hasBound {
+		c.BBox = NewBBox(bound)
+	}
+	if c.BBox != nil {
+		c.BBox = cfg.bbox(c.BBox)
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return marshalForeignMembers(data, fc.ForeignMembers, featureCollectionMembers)
+}
+
+// UnmarshalJSON handles the correct unmarshalling of the data,
+// the foreign members are kept in ForeignMembers.
+func (fc *FeatureCollection) UnmarshalJSON(data []byte) error {
+	type tempFC FeatureCollection
+
+	c := tempFC{}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return err
+	}
+
+	members, err := unmarshalForeignMembers(data, featureCollectionMembers)
+	if err != nil {
+		return err
+	}
+	c.ForeignMembers = members
+
+	*fc = FeatureCollection(c)
+	return nil
 }
 
 // String returns string.
@@ -11,11 +11,18 @@ import (
 // Encoder defines geojson encoder.
 type Encoder struct {
 	BaseEncoder
+
+	// Options are the marshal options used by Encode and WriteGeoJSON.
+	Options []MarshalOption
 }
 
 // Encode Returns string of that encode geometry  by codeType.
 func (e *Encoder) Encode(g space.Geometry) []byte {
 	gj := &Geometry{Coordinates: g}
+	if len(e.Options) > 0 {
+		data, _ := gj.MarshalWithOptions(e.Options...)
+		return data
+	}
 	data, _ := gj.MarshalJSON()
 	return data
 }
@@ -66,7 +73,13 @@ func (e *Encoder) Write(w io.Writer, g space.Geometry) error {
 
 // WriteGeoJSON write geometry to writer  by codeType.
 func (e *Encoder) WriteGeoJSON(w io.Writer, g *FeatureCollection) error {
-	buf, err := g.MarshalJSON()
+	var buf []byte
+	var err error
+	if len(e.Options) > 0 {
+		buf, err = g.MarshalWithOptions(e.Options...)
+	} else {
+		buf, err = g.MarshalJSON()
+	}
 	if err != nil {
 		return err
 	}
@@ -97,6 +97,34 @@ func (g Geometry) MarshalJSON() ([]byte, error) {
 	return json.Marshal(ng)
 }
 
+// MarshalWithOptions will marshal the geometry into the json structure with the options,
+// e.g. the precision of coordinates and the right-hand rule winding of rings.
+func (g Geometry) MarshalWithOptions(opts ...MarshalOption) ([]byte, error) {
+	return g.applyConfig(newMarshalConfig(opts...)).MarshalJSON()
+}
+
+// applyConfig returns a copy of the geometry that the config applied,
+// Rings and Bounds are converted into Polygons before.
+func (g Geometry) applyConfig(cfg *MarshalConfig) *Geometry {
+	var jg *Geometry
+	if g.Coordinates != nil {
+		jg = NewGeometry(g.Coordinates)
+	} else {
+		jg = &Geometry{Type: g.Type, Geometries: g.Geometries}
+	}
+	if jg.Coordinates != nil {
+		jg.Coordinates = cfg.geometry(jg.Coordinates)
+	}
+	if len(jg.Geometries) > 0 {
+		geometries := make([]*Geometry, 0, len(jg.Geometries))
+		for _, v := range jg.Geometries {
+			geometries = append(geometries, v.applyConfig(cfg))
+		}
+		jg.Geometries = geometries
+	}
+	return jg
+}
+
 // UnmarshalGeometry decodes the data into a GeoJSON feature.
 // Alternately one can call json.Unmarshal(g) directly for the same result.
 func UnmarshalGeometry(data []byte) (*Geometry, error) {
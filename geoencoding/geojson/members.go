@@ -0,0 +1,77 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// unmarshalForeignMembers returns the members of the json object that are not in the defined members,
+// returns nil if there is no foreign member.
+func unmarshalForeignMembers(data []byte, defined []string) (map[string]interface{}, error) {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	for _, key := range defined {
+		delete(raw, key)
+	}
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	members := make(map[string]interface{}, len(raw))
+	for key, v := range raw {
+		var value interface{}
+		if err := json.Unmarshal(v, &value); err != nil {
+			return nil, err
+		}
+		members[key] = value
+	}
+	return members, nil
+}
+
+// marshalForeignMembers appends the foreign members to the marshalled json object,
+// the members that collide with the defined members are ignored.
+func marshalForeignMembers(data []byte, members map[string]interface{}, defined []string) ([]byte, error) {
+	if len(members) == 0 {
+		return data, nil
+	}
+
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		isDefined := false
+		for _, v := range defined {
+			if key == v {
+				isDefined = true
+				break
+			}
+		}
+		if !isDefined {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return data, nil
+	}
+	sort.Strings(keys)
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)+32*len(keys)))
+	buf.Write(data[:len(data)-1])
+	for _, key := range keys {
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(members[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteByte(',')
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
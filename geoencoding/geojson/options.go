@@ -0,0 +1,169 @@
+package geojson
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/space"
+)
+
+// FullPrecision keeps the coordinates in full float64 precision.
+const FullPrecision = -1
+
+// MarshalConfig defines the options used when marshalling GeoJSON objects.
+type MarshalConfig struct {
+	// Precision is the number of decimal digits of the coordinates, FullPrecision keeps all digits.
+	Precision int
+	// RightHandRule winds the exterior rings counterclockwise and the holes clockwise (RFC 7946 3.1.6).
+	RightHandRule bool
+	// BBox computes the bbox member of Feature and FeatureCollection when it is not set.
+	BBox bool
+}
+
+// MarshalOption defines a marshal option.
+type MarshalOption func(o *MarshalConfig)
+
+// WithPrecision rounds the coordinates to the number of decimal digits.
+func WithPrecision(precision int) MarshalOption {
+	return func(o *MarshalConfig) {
+		o.Precision = precision
+	}
+}
+
+// WithRightHandRule winds the polygon rings by the right-hand rule.
+func WithRightHandRule() MarshalOption {
+	return func(o *MarshalConfig) {
+		o.RightHandRule = true
+	}
+}
+
+// WithBBox computes the bbox member of Feature and FeatureCollection.
+func WithBBox() MarshalOption {
+	return func(o *MarshalConfig) {
+		o.BBox = true
+	}
+}
+
+// WithRFC7946 applies the right-hand rule and computes the bbox,
+// the coordinates are rounded to 6 decimal digits as RFC 7946 11.2 recommends.
+func WithRFC7946() MarshalOption {
+	return func(o *MarshalConfig) {
+		o.Precision = 6
+		o.RightHandRule = true
+		o.BBox = true
+	}
+}
+
+func newMarshalConfig(opts ...MarshalOption) *MarshalConfig {
+	cfg := &MarshalConfig{
+		Precision: FullPrecision,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// round returns the value rounded to the precision.
+func (c *MarshalConfig) round(v float64) float64 {
+	if c.Precision < 0 {
+		return v
+	}
+	p := math.Pow10(c.Precision)
+	return math.Round(v*p) / p
+}
+
+// bbox returns the bbox rounded to the precision.
+func (c *MarshalConfig) bbox(bb BBox) BBox {
+	nbb := make(BBox, len(bb))
+	for i, v := range bb {
+		nbb[i] = c.round(v)
+	}
+	return nbb
+}
+
+// point returns a copy of the point rounded to the precision.
+func (c *MarshalConfig) point(p space.Point) space.Point {
+	np := make(space.Point, len(p))
+	for i, v := range p {
+		np[i] = c.round(v)
+	}
+	return np
+}
+
+// line returns a copy of the line rounded to the precision.
+func (c *MarshalConfig) line(ls space.LineString) space.LineString {
+	nls := make(space.LineString, len(ls))
+	for i, v := range ls {
+		nls[i] = c.point(v)
+	}
+	return nls
+}
+
+// polygon returns a copy of the polygon rounded to the precision and
+// wound by the right-hand rule if it is required.
+func (c *MarshalConfig) polygon(p space.Polygon) space.Polygon {
+	np := make(space.Polygon, len(p))
+	for i, v := range p {
+		ring := c.line(v)
+		if c.RightHandRule {
+			// AreaDirection is positive when the ring is clockwise.
+			clockwise := measure.AreaDirection(matrix.LineMatrix(ring)) > 0
+			if (i == 0) == clockwise {
+				ring = space.LineString(matrix.LineMatrix(ring).Reverse())
+			}
+		}
+		np[i] = ring
+	}
+	return np
+}
+
+// geometry returns a copy of the geometry that the options applied.
+func (c *MarshalConfig) geometry(g space.Geometry) space.Geometry {
+	switch g := g.(type) {
+	case space.Point:
+		return c.point(g)
+	case space.MultiPoint:
+		mp := make(space.MultiPoint, len(g))
+		for i, v := range g {
+			mp[i] = c.point(v)
+		}
+		return mp
+	case space.LineString:
+		return c.line(g)
+	case space.MultiLineString:
+		mls := make(space.MultiLineString, len(g))
+		for i, v := range g {
+			mls[i] = c.line(v)
+		}
+		return mls
+	case space.Polygon:
+		return c.polygon(g)
+	case space.MultiPolygon:
+		mp := make(space.MultiPolygon, len(g))
+		for i, v := range g {
+			mp[i] = c.polygon(v)
+		}
+		return mp
+	default:
+		return g
+	}
+}
+
+// geometryBound returns the bound of the geojson geometry, ok is false if geometry is empty.
+func geometryBound(g *Geometry) (bound space.Bound, ok bool) {
+	if g == nil {
+		return
+	}
+	geom := g.Geometry()
+	if geom == nil || geom.IsEmpty() {
+		return
+	}
+	return geom.Bound(), true
+}
+
+// extendBound grows the bound to include the other bound.
+func extendBound(b, other space.Bound) space.Bound {
+	return b.Extend(other.Min).Extend(other.Max)
+}
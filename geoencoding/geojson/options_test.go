@@ -0,0 +1,137 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+func TestGeometryMarshalWithOptions(t *testing.T) {
+	cases := []struct {
+		name   string
+		geom   space.Geometry
+		opts   []MarshalOption
+		expect string
+	}{
+		{
+			name:   "full precision",
+			geom:   space.Point{1.123456789, 2.987654321},
+			opts:   nil,
+			expect: `{"type":"Point","coordinates":[1.123456789,2.987654321]}`,
+		},
+		{
+			name:   "precision",
+			geom:   space.Point{1.123456789, 2.987654321},
+			opts:   []MarshalOption{WithPrecision(3)},
+			expect: `{"type":"Point","coordinates":[1.123,2.988]}`,
+		},
+		{
+			name:   "right hand rule shell",
+			geom:   space.Polygon{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}},
+			opts:   []MarshalOption{WithRightHandRule()},
+			expect: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1],[0,0]]]}`,
+		},
+		{
+			name: "right hand rule hole",
+			geom: space.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+				{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}},
+			opts:   []MarshalOption{WithRightHandRule()},
+			expect: `{"type":"Polygon","coordinates":[[[0,0],[4,0],[4,4],[0,4],[0,0]],[[1,1],[1,2],[2,2],[2,1],[1,1]]]}`,
+		},
+		{
+			name: "collection",
+			geom: space.Collection{space.Point{1.55, 2}, space.LineString{{1.04, 1}, {2, 2.06}}},
+			opts: []MarshalOption{WithPrecision(1)},
+			expect: `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1.6,2]},` +
+				`{"type":"LineString","coordinates":[[1,1],[2,2.1]]}]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := NewGeometry(tc.geom).MarshalWithOptions(tc.opts...)
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+			if string(data) != tc.expect {
+				t.Errorf("incorrect json: %v != %v", string(data), tc.expect)
+			}
+		})
+	}
+}
+
+func TestMarshalWithOptions_NotModifyInput(t *testing.T) {
+	poly := space.Polygon{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}}
+	_, err := NewGeometry(poly).MarshalWithOptions(WithRFC7946())
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if !poly.Equals(space.Polygon{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}}) {
+		t.Errorf("input should not be modified: %v", poly)
+	}
+}
+
+func TestFeatureCollectionMarshalWithOptions_BBox(t *testing.T) {
+	fc := NewFeatureCollection()
+	fc.Append(NewFeature(*NewGeometry(space.Point{1.00001, 2})))
+	fc.Append(NewFeature(*NewGeometry(space.LineString{{-1, 3}, {0.5, 5.12345}})))
+
+	data, err := fc.MarshalWithOptions(WithBBox(), WithPrecision(2))
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	if !bytes.Contains(data, []byte(`{"type":"FeatureCollection","bbox":[-1,2,1,5.12]`)) {
+		t.Errorf("should compute the bbox of collection: %v", string(data))
+	}
+	if !bytes.Contains(data, []byte(`"bbox":[-1,3,0.5,5.12]`)) {
+		t.Errorf("should compute the bbox of feature: %v", string(data))
+	}
+
+	data, err = fc.MarshalJSON()
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if bytes.Contains(data, []byte(`"bbox"`)) {
+		t.Errorf("should not set the bbox value: %v", string(data))
+	}
+}
+
+func TestForeignMembers(t *testing.T) {
+	rawJSON := `{"type":"FeatureCollection","name":"roads","crs":{"type":"name"},"features":[
+		{"type":"Feature","title":"road","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"a":1}}]}`
+
+	fc, err := UnmarshalFeatureCollection([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if fc.ForeignMembers["name"] != "roads" {
+		t.Errorf("should keep foreign member of collection: %v", fc.ForeignMembers)
+	}
+	if fc.Features[0].ForeignMembers["title"] != "road" {
+		t.Errorf("should keep foreign member of feature: %v", fc.Features[0].ForeignMembers)
+	}
+
+	data, err := json.Marshal(fc)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	expect := `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},` +
+		`"properties":{"a":1},"title":"road"}],"crs":{"type":"name"},"name":"roads"}`
+	if string(data) != expect {
+		t.Errorf("incorrect json: %v != %v", string(data), expect)
+	}
+
+	f := NewFeature(*NewGeometry(space.Point{1, 2}))
+	f.ForeignMembers = map[string]interface{}{"type": "other", "title": "a"}
+	data, err = f.MarshalWithOptions()
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if strings.Contains(string(data), `"other"`) || !strings.Contains(string(data), `"title":"a"`) {
+		t.Errorf("should ignore foreign member that collides with defined member: %v", string(data))
+	}
+}
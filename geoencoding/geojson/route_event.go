@@ -0,0 +1,95 @@
+package geojson
+
+import (
+	"fmt"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+// LocateEvents locates the events on the measured routes, it is the dynamic segmentation of the event table.
+// The events are joined to the routes by the property routeKey, which the routes and the events both have.
+// The measure of a point event is the property fromKey, a line event spans the measures from fromKey to toKey,
+// all the events are point events if toKey is empty.
+// The routes are the LineString or MultiLineString whose coordinates carry the measure as the fourth ordinate {x, y, z, m}.
+// Each located event is a feature with the properties of event and the geometry on its route,
+// a Point or MultiPoint for the point event and a LineString or MultiLineString for the line event.
+// The events without the route or outside the measures of route are not in the result.
+func LocateEvents(routes, events *FeatureCollection, routeKey, fromKey, toKey string) (*FeatureCollection, error) {
+	result := NewFeatureCollection()
+	if routes == nil || events == nil {
+		return result, nil
+	}
+	lines := map[string][]space.LineString{}
+	for _, route := range routes.Features {
+		id := fmt.Sprint(route.Properties[routeKey])
+		switch g := route.Geometry.Geometry().(type) {
+		case space.LineString:
+			lines[id] = append(lines[id], g)
+		case space.MultiLineString:
+			lines[id] = append(lines[id], g...)
+		}
+	}
+
+	for _, event := range events.Features {
+		routeLines, ok := lines[fmt.Sprint(event.Properties[routeKey])]
+		if !ok {
+			continue
+		}
+		from, err := measureProperty(event.Properties, fromKey)
+		if err != nil {
+			return nil, err
+		}
+		to := from
+		if toKey != "" {
+			if to, err = measureProperty(event.Properties, toKey); err != nil {
+				return nil, err
+			}
+		}
+
+		points, parts := space.MultiPoint{}, space.MultiLineString{}
+		for _, line := range routeLines {
+			if toKey == "" {
+				located, err := line.LocateAlong(from)
+				if err != nil {
+					return nil, fmt.Errorf("geojson: route %v: %w", event.Properties[routeKey], err)
+				}
+				points = append(points, located.(space.MultiPoint)...)
+				continue
+			}
+			located, err := line.LocateBetween(from, to)
+			if err != nil {
+				return nil, fmt.Errorf("geojson: route %v: %w", event.Properties[routeKey], err)
+			}
+			parts = append(parts, located.(space.MultiLineString)...)
+		}
+
+		var geom space.Geometry
+		switch {
+		case len(points) == 1:
+			geom = points[0]
+		case len(points) > 1:
+			geom = points
+		case len(parts) == 1:
+			geom = parts[0]
+		case len(parts) > 1:
+			geom = parts
+		default:
+			continue
+		}
+		feature := NewFeature(*NewGeometry(geom))
+		feature.ID = event.ID
+		for k, v := range event.Properties {
+			feature.Properties[k] = v
+		}
+		result.Append(feature)
+	}
+	return result, nil
+}
+
+// measureProperty returns the measure of the property key, the numbers in strings are accepted.
+func measureProperty(p Properties, key string) (float64, error) {
+	if m, ok := toFloat64(p[key]); ok {
+		return m, nil
+	}
+	return 0, fmt.Errorf("geojson: measure %q should be a number, but a %T: %v", key, p[key], p[key])
+}
@@ -0,0 +1,56 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+func TestLocateEvents(t *testing.T) {
+	routes, err := UnmarshalFeatureCollection([]byte(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{"route":"R1"},
+		"geometry":{"type":"LineString","coordinates":[[0,0,0,100],[10,0,0,110],[10,10,0,120]]}}]}`))
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	events, err := UnmarshalFeatureCollection([]byte(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","id":1,"properties":{"route":"R1","from":105,"to":115,"kind":"pavement"},"geometry":{"type":"Point","coordinates":[0,0]}},
+		{"type":"Feature","id":2,"properties":{"route":"R1","from":130,"to":140},"geometry":{"type":"Point","coordinates":[0,0]}},
+		{"type":"Feature","id":3,"properties":{"route":"R2","from":105,"to":115},"geometry":{"type":"Point","coordinates":[0,0]}}]}`))
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	located, err := LocateEvents(routes, events, "route", "from", "to")
+	if err != nil {
+		t.Fatalf("locate events error: %v", err)
+	}
+	if len(located.Features) != 1 {
+		t.Fatalf("should locate one event, but %v", len(located.Features))
+	}
+	want := space.LineString{{5, 0, 0, 105}, {10, 0, 0, 110}, {10, 5, 0, 115}}
+	if f := located.Features[0]; !f.Geometry.Geometry().Equals(want) || f.Properties.MustString("kind") != "pavement" {
+		t.Errorf("incorrect event: %v %v", f.Geometry.Geometry(), f.Properties)
+	}
+
+	located, err = LocateEvents(routes, events, "route", "from", "")
+	if err != nil {
+		t.Fatalf("locate events error: %v", err)
+	}
+	if len(located.Features) != 1 || !located.Features[0].Geometry.Geometry().Equals(space.Point{5, 0, 0, 105}) {
+		t.Errorf("incorrect point events: %v", located)
+	}
+
+	events.Features[0].Properties["from"] = "105"
+	if located, err := LocateEvents(routes, events, "route", "from", "to"); err != nil || len(located.Features) != 1 {
+		t.Errorf("should locate the event with the measure in string: %v %v", located, err)
+	}
+	if located, err := LocateEvents(nil, events, "route", "from", "to"); err != nil || len(located.Features) != 0 {
+		t.Errorf("should locate no event without routes: %v %v", located, err)
+	}
+
+	events.Features[0].Properties["from"] = "a"
+	if _, err := LocateEvents(routes, events, "route", "from", "to"); err == nil {
+		t.Errorf("should return error if measure is not a number")
+	}
+}
@@ -0,0 +1,146 @@
+package geojson
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+var (
+	_ sql.Scanner   = &SQLGeometry{}
+	_ driver.Valuer = SQLGeometry{}
+)
+
+// ErrUnsupportedDataType will be returned if the scanned value is not text.
+var ErrUnsupportedDataType = errors.New("geojson: scan value must be []byte or string")
+
+// ErrUnsupportedCRS will be returned if the crs member of GeoJSON is not an EPSG code.
+var ErrUnsupportedCRS = errors.New("geojson: unsupported crs")
+
+// geometryMembers are the members of the GeoJSON geometry.
+var geometryMembers = []string{"type", "coordinates", "geometries"}
+
+// SQLGeometry is a geometry stored as GeoJSON text in the database,
+// e.g. a json column or the result of ST_AsGeoJSON.
+// It can be used as a scan destination and as a query argument:
+//
+//	var g geojson.SQLGeometry
+//	err := db.QueryRow("SELECT ST_AsGeoJSON(geom, 9, 2) FROM foo WHERE id=$1", id).Scan(&g)
+//	...
+//	_, err = db.Exec("INSERT INTO foo (geom) VALUES (ST_GeomFromGeoJSON($1))", g)
+//
+// The SRID is read from the named crs member like "EPSG:3857", it is WGS84 if there is no crs member.
+// Geometry is restored as *space.GeometryValid with the SRID as coordinate system.
+type SQLGeometry struct {
+	Geometry space.Geometry
+	// SRID is the spatial reference id, if it is 0 when valued,
+	// the coordinate system of *space.GeometryValid is used.
+	SRID  int
+	Valid bool // Valid is true if the geometry is not NULL
+}
+
+// Scan will scan the GeoJSON text into the geometry.
+func (g *SQLGeometry) Scan(d interface{}) error {
+	*g = SQLGeometry{}
+
+	var data []byte
+	switch v := d.(type) {
+	case nil:
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return ErrUnsupportedDataType
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	jg, err := UnmarshalGeometry(data)
+	if err != nil {
+		return err
+	}
+	member := &struct {
+		CRS *jsonCRS `json:"crs"`
+	}{}
+	if err := json.Unmarshal(data, member); err != nil {
+		return err
+	}
+	srid, err := member.CRS.srid()
+	if err != nil {
+		return err
+	}
+	if g.Geometry, err = space.CreateElementValidWithCoordSys(jg.Geometry(), srid); err != nil {
+		return err
+	}
+	g.SRID = srid
+	g.Valid = true
+	return nil
+}
+
+// Value will encode the geometry into GeoJSON text,
+// the named crs member is added if the SRID is not WGS84.
+func (g SQLGeometry) Value() (driver.Value, error) {
+	if g.Geometry == nil {
+		return nil, nil
+	}
+	geom, srid := g.Geometry, g.SRID
+	if v, ok := geom.(*space.GeometryValid); ok {
+		if srid == 0 {
+			srid = v.CoordinateSystem()
+		}
+		geom = v.Geom()
+	}
+
+	data, err := json.Marshal(NewGeometry(geom))
+	if err != nil {
+		return nil, err
+	}
+	if srid != 0 && srid != space.WGS84 {
+		crs := &jsonCRS{Type: "name"}
+		crs.Properties.Name = fmt.Sprintf("EPSG:%d", srid)
+		if data, err = marshalForeignMembers(data, map[string]interface{}{"crs": crs}, geometryMembers); err != nil {
+			return nil, err
+		}
+	}
+	return string(data), nil
+}
+
+// jsonCRS is the named crs member of the GeoJSON 2008 specification.
+type jsonCRS struct {
+	Type       string `json:"type"`
+	Properties struct {
+		Name string `json:"name"`
+	} `json:"properties"`
+}
+
+// srid returns the EPSG code of the crs, e.g. "EPSG:3857" or "urn:ogc:def:crs:EPSG::3857",
+// returns WGS84 if the crs is nil.
+func (c *jsonCRS) srid() (int, error) {
+	if c == nil {
+		return space.WGS84, nil
+	}
+	name := c.Properties.Name
+	if c.Type != "name" || name == "" {
+		return 0, ErrUnsupportedCRS
+	}
+	if strings.HasSuffix(name, "CRS84") {
+		return space.WGS84, nil
+	}
+	if !strings.Contains(name, "EPSG") {
+		return 0, ErrUnsupportedCRS
+	}
+	srid, err := strconv.Atoi(name[strings.LastIndex(name, ":")+1:])
+	if err != nil {
+		return 0, ErrUnsupportedCRS
+	}
+	return srid, nil
+}
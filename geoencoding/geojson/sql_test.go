@@ -0,0 +1,93 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+func TestSQLGeometry(t *testing.T) {
+	cases := []struct {
+		name string
+		data interface{}
+		geom space.Geometry
+		srid int
+	}{
+		{
+			name: "without crs",
+			data: []byte(`{"type":"Point","coordinates":[1,2]}`),
+			geom: space.Point{1, 2},
+			srid: space.WGS84,
+		},
+		{
+			name: "epsg crs",
+			data: `{"type":"LineString","crs":{"type":"name","properties":{"name":"EPSG:3857"}},"coordinates":[[1,2],[3,4]]}`,
+			geom: space.LineString{{1, 2}, {3, 4}},
+			srid: space.PseudoMercator,
+		},
+		{
+			name: "urn crs",
+			data: `{"type":"Point","crs":{"type":"name","properties":{"name":"urn:ogc:def:crs:EPSG::3857"}},"coordinates":[1,2]}`,
+			geom: space.Point{1, 2},
+			srid: space.PseudoMercator,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var g SQLGeometry
+			if err := g.Scan(tc.data); err != nil {
+				t.Fatalf("scan error: %v", err)
+			}
+			if !g.Valid || g.SRID != tc.srid || !g.Geometry.Equals(tc.geom) {
+				t.Errorf("incorrect scan: %v, %v", g.SRID, g.Geometry)
+			}
+			if g.Geometry.CoordinateSystem() != tc.srid {
+				t.Errorf("incorrect coordinate system: %v != %v", g.Geometry.CoordinateSystem(), tc.srid)
+			}
+		})
+	}
+
+	var g SQLGeometry
+	if err := g.Scan(nil); err != nil || g.Valid {
+		t.Errorf("should noop for nil data: %v", err)
+	}
+	if err := g.Scan(`{"type":"Point","crs":{"type":"link"},"coordinates":[1,2]}`); err != ErrUnsupportedCRS {
+		t.Errorf("should not scan the linked crs: %v", err)
+	}
+}
+
+func TestSQLGeometry_Value(t *testing.T) {
+	geom, _ := space.CreateElementValidWithCoordSys(space.Point{1, 2}, space.PseudoMercator)
+	cases := []struct {
+		name   string
+		geom   SQLGeometry
+		expect interface{}
+	}{
+		{
+			name:   "coordinate system",
+			geom:   SQLGeometry{Geometry: geom},
+			expect: `{"type":"Point","coordinates":[1,2],"crs":{"type":"name","properties":{"name":"EPSG:3857"}}}`,
+		},
+		{
+			name:   "wgs84",
+			geom:   SQLGeometry{Geometry: space.Point{1, 2}, SRID: space.WGS84},
+			expect: `{"type":"Point","coordinates":[1,2]}`,
+		},
+		{
+			name:   "null",
+			geom:   SQLGeometry{},
+			expect: nil,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := tc.geom.Value()
+			if err != nil {
+				t.Fatalf("value error: %v", err)
+			}
+			if val != tc.expect {
+				t.Errorf("incorrect value: %v != %v", val, tc.expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,415 @@
+package wkb
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+var (
+	_ sql.Scanner   = &EWKBGeometry{}
+	_ driver.Valuer = EWKBGeometry{}
+	_ sql.Scanner   = &MySQLGeometry{}
+	_ driver.Valuer = MySQLGeometry{}
+	_ sql.Scanner   = &SpatiaLiteGeometry{}
+	_ driver.Valuer = SpatiaLiteGeometry{}
+)
+
+// flags of the EWKB geometry type.
+const (
+	ewkbZFlag    uint32 = 0x80000000
+	ewkbMFlag    uint32 = 0x40000000
+	ewkbSRIDFlag uint32 = 0x20000000
+)
+
+// bytes of the SpatiaLite geometry blob.
+const (
+	spatiaLiteStart  byte = 0x00
+	spatiaLiteMBREnd byte = 0x7C
+	spatiaLiteEntity byte = 0x69
+	spatiaLiteEnd    byte = 0xFE
+
+	// spatiaLiteHeaderLength is the length of start, endian, srid, mbr and mbr end.
+	spatiaLiteHeaderLength = 39
+)
+
+// EWKBGeometry is a geometry with SRID stored as PostGIS EWKB.
+// It can be used as a scan destination and as a query argument:
+//
+//	var g wkb.EWKBGeometry
+//	err := db.QueryRow("SELECT ST_AsEWKB(geom) FROM foo WHERE id=$1", id).Scan(&g)
+//	...
+//	_, err = db.Exec("INSERT INTO foo (geom) VALUES (ST_GeomFromEWKB($1))", g)
+//
+// Scan accepts the binary EWKB and the hex encoded EWKB that PostgreSQL returns for the geometry columns.
+// If the data has a SRID, Geometry is restored as *space.GeometryValid with the SRID as coordinate system.
+type EWKBGeometry struct {
+	Geometry space.Geometry
+	// SRID is the spatial reference id, if it is 0 when valued,
+	// the coordinate system of *space.GeometryValid is used.
+	SRID  int
+	Valid bool // Valid is true if the geometry is not NULL
+}
+
+// Scan will scan the EWKB data into the geometry.
+func (g *EWKBGeometry) Scan(d interface{}) error {
+	*g = EWKBGeometry{}
+	data, err := scanData(d)
+	if err != nil || data == nil {
+		return err
+	}
+
+	geom, srid, err := unmarshalEWKB(data)
+	if err != nil {
+		return err
+	}
+	if g.Geometry, err = withSRID(geom, srid); err != nil {
+		return err
+	}
+	g.SRID = srid
+	g.Valid = true
+	return nil
+}
+
+// Value will encode the geometry into EWKB, the SRID is encoded if it is not 0.
+func (g EWKBGeometry) Value() (driver.Value, error) {
+	if g.Geometry == nil {
+		return nil, nil
+	}
+	geom, srid := splitSRID(g.Geometry, g.SRID)
+	data, err := marshalEWKB(geom, srid)
+	if data == nil {
+		return nil, err
+	}
+	return data, err
+}
+
+// MySQLGeometry is a geometry with SRID stored in the MySQL and MariaDB internal format,
+// it is a 4 byte little endian SRID followed by the WKB.
+//
+//	var g wkb.MySQLGeometry
+//	err := db.QueryRow("SELECT geom FROM foo WHERE id=?", id).Scan(&g)
+//
+// If the SRID is not 0, Geometry is restored as *space.GeometryValid with the SRID as coordinate system.
+type MySQLGeometry struct {
+	Geometry space.Geometry
+	// SRID is the spatial reference id, if it is 0 when valued,
+	// the coordinate system of *space.GeometryValid is used.
+	SRID  int
+	Valid bool // Valid is true if the geometry is not NULL
+}
+
+// Scan will scan the MySQL geometry data into the geometry.
+func (g *MySQLGeometry) Scan(d interface{}) error {
+	*g = MySQLGeometry{}
+	data, err := scanData(d)
+	if err != nil || data == nil {
+		return err
+	}
+	if len(data) < 4 {
+		return ErrNotWKB
+	}
+
+	srid := int(binary.LittleEndian.Uint32(data))
+	geom, err := Unmarshal(data[4:])
+	if err != nil {
+		return err
+	}
+	if g.Geometry, err = withSRID(geom, srid); err != nil {
+		return err
+	}
+	g.SRID = srid
+	g.Valid = true
+	return nil
+}
+
+// Value will encode the geometry into the MySQL internal format.
+func (g MySQLGeometry) Value() (driver.Value, error) {
+	if g.Geometry == nil {
+		return nil, nil
+	}
+	geom, srid := splitSRID(g.Geometry, g.SRID)
+	data, err := Marshal(geom)
+	if data == nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 4+len(data))
+	binary.LittleEndian.PutUint32(buf, uint32(srid))
+	copy(buf[4:], data)
+	return buf, nil
+}
+
+// SpatiaLiteGeometry is a geometry with SRID stored as SpatiaLite geometry blob.
+//
+//	var g wkb.SpatiaLiteGeometry
+//	err := db.QueryRow("SELECT geom FROM foo WHERE id=?", id).Scan(&g)
+//
+// The compressed and the 3D geometries are not supported.
+// If the SRID is not 0, Geometry is restored as *space.GeometryValid with the SRID as coordinate system.
+type SpatiaLiteGeometry struct {
+	Geometry space.Geometry
+	// SRID is the spatial reference id, if it is 0 when valued,
+	// the coordinate system of *space.GeometryValid is used.
+	SRID  int
+	Valid bool // Valid is true if the geometry is not NULL
+}
+
+// Scan will scan the SpatiaLite geometry blob into the geometry.
+func (g *SpatiaLiteGeometry) Scan(d interface{}) error {
+	*g = SpatiaLiteGeometry{}
+	data, err := scanData(d)
+	if err != nil || data == nil {
+		return err
+	}
+
+	geom, srid, err := unmarshalSpatiaLite(data)
+	if err != nil {
+		return err
+	}
+	if g.Geometry, err = withSRID(geom, srid); err != nil {
+		return err
+	}
+	g.SRID = srid
+	g.Valid = true
+	return nil
+}
+
+// Value will encode the geometry into the SpatiaLite geometry blob.
+func (g SpatiaLiteGeometry) Value() (driver.Value, error) {
+	if g.Geometry == nil {
+		return nil, nil
+	}
+	geom, srid := splitSRID(g.Geometry, g.SRID)
+	data, err := marshalSpatiaLite(geom, srid)
+	if data == nil {
+		return nil, err
+	}
+	return data, err
+}
+
+// scanData returns the binary data of the scanned value,
+// the hex encoded data is converted to binary.
+func scanData(d interface{}) ([]byte, error) {
+	var data []byte
+	switch v := d.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return nil, ErrUnsupportedDataType
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	// go-pg returns the data as `\xhexencoded`.
+	if len(data) > 2 && data[0] == '\\' && data[1] == 'x' {
+		data = data[2:]
+	} else if !isHex(data) {
+		return data, nil
+	}
+	b := make([]byte, hex.DecodedLen(len(data)))
+	if _, err := hex.Decode(b, data); err != nil {
+		return nil, ErrNotWKB
+	}
+	return b, nil
+}
+
+// isHex returns true if the data is hex encoded text, the binary geometry data
+// always contains a byte that is not a hex digit.
+func isHex(data []byte) bool {
+	if len(data)%2 != 0 {
+		return false
+	}
+	for _, c := range data {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// splitSRID returns the geometry without coordinate system and the SRID,
+// the coordinate system of *space.GeometryValid is used if srid is 0.
+func splitSRID(geom space.Geometry, srid int) (space.Geometry, int) {
+	if g, ok := geom.(*space.GeometryValid); ok {
+		if srid == 0 {
+			srid = g.CoordinateSystem()
+		}
+		geom = g.Geom()
+	}
+	return geom, srid
+}
+
+// withSRID returns the geometry with the SRID as coordinate system, returns the geometry if srid is 0.
+func withSRID(geom space.Geometry, srid int) (space.Geometry, error) {
+	if srid == 0 {
+		return geom, nil
+	}
+	return space.CreateElementValidWithCoordSys(geom, srid)
+}
+
+// unmarshalEWKB returns the geometry and the SRID of the EWKB data.
+func unmarshalEWKB(data []byte) (space.Geometry, int, error) {
+	order, typ, err := byteOrderType(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if typ&(ewkbZFlag|ewkbMFlag) != 0 {
+		return nil, 0, ErrUnsupportedGeometry
+	}
+	if typ&ewkbSRIDFlag == 0 {
+		geom, err := Unmarshal(data)
+		return geom, 0, err
+	}
+	if len(data) < 9 {
+		return nil, 0, ErrNotWKB
+	}
+
+	srid := int(order.Uint32(data[5:]))
+	buf := make([]byte, len(data)-4)
+	buf[0] = data[0]
+	order.PutUint32(buf[1:], typ&^ewkbSRIDFlag)
+	copy(buf[5:], data[9:])
+	geom, err := Unmarshal(buf)
+	return geom, srid, err
+}
+
+// marshalEWKB encodes the geometry into EWKB, only the outermost geometry has the SRID.
+func marshalEWKB(geom space.Geometry, srid int) ([]byte, error) {
+	data, err := Marshal(geom)
+	if err != nil || data == nil || srid == 0 {
+		return data, err
+	}
+
+	order := byteOrder(data[0])
+	buf := make([]byte, len(data)+4)
+	buf[0] = data[0]
+	order.PutUint32(buf[1:], order.Uint32(data[1:])|ewkbSRIDFlag)
+	order.PutUint32(buf[5:], uint32(srid))
+	copy(buf[9:], data[5:])
+	return buf, nil
+}
+
+// unmarshalSpatiaLite returns the geometry and the SRID of the SpatiaLite geometry blob.
+func unmarshalSpatiaLite(data []byte) (space.Geometry, int, error) {
+	if len(data) < spatiaLiteHeaderLength+5 || data[0] != spatiaLiteStart ||
+		data[spatiaLiteHeaderLength-1] != spatiaLiteMBREnd || data[len(data)-1] != spatiaLiteEnd {
+		return nil, 0, ErrNotWKB
+	}
+	if data[1] != byte(bigEndian) && data[1] != byte(littleEndian) {
+		return nil, 0, ErrNotWKB
+	}
+	order := byteOrder(data[1])
+	srid := int(order.Uint32(data[2:]))
+
+	// the class type and the body are WKB, except that the nested entities start with the entity mark.
+	buf := make([]byte, len(data)-spatiaLiteHeaderLength)
+	buf[0] = data[1]
+	copy(buf[1:], data[spatiaLiteHeaderLength:len(data)-1])
+	if _, err := markEntities(buf, order, data[1]); err != nil {
+		return nil, 0, err
+	}
+	geom, err := Unmarshal(buf)
+	return geom, srid, err
+}
+
+// marshalSpatiaLite encodes the geometry into the SpatiaLite geometry blob.
+func marshalSpatiaLite(geom space.Geometry, srid int) ([]byte, error) {
+	data, err := Marshal(geom)
+	if err != nil || data == nil {
+		return data, err
+	}
+	order := byteOrder(data[0])
+	if _, err := markEntities(data, order, spatiaLiteEntity); err != nil {
+		return nil, err
+	}
+
+	bound := geom.Bound()
+	buf := make([]byte, spatiaLiteHeaderLength, spatiaLiteHeaderLength+len(data))
+	buf[0] = spatiaLiteStart
+	buf[1] = data[0]
+	order.PutUint32(buf[2:], uint32(srid))
+	for i, v := range []float64{bound.Min.X(), bound.Min.Y(), bound.Max.X(), bound.Max.Y()} {
+		order.PutUint64(buf[6+8*i:], math.Float64bits(v))
+	}
+	buf[spatiaLiteHeaderLength-1] = spatiaLiteMBREnd
+	buf = append(buf, data[1:]...)
+	return append(buf, spatiaLiteEnd), nil
+}
+
+// markEntities sets the first byte of the nested entities of the 2D WKB data to mark,
+// and returns the length of the outermost geometry.
+func markEntities(data []byte, order byteOrder, mark byte) (int, error) {
+	if len(data) < 5 {
+		return 0, ErrNotWKB
+	}
+	typ := order.Uint32(data[1:])
+	offset := 5
+
+	count := func(size int) (int, error) {
+		if len(data) < offset+4 {
+			return 0, ErrNotWKB
+		}
+		n := int(order.Uint32(data[offset:]))
+		offset += 4
+		if n < 0 || n > (len(data)-offset)/size {
+			return 0, ErrNotWKB
+		}
+		return n, nil
+	}
+
+	switch typ {
+	case pointType:
+		offset += 16
+	case lineStringType:
+		n, err := count(16)
+		if err != nil {
+			return 0, err
+		}
+		offset += 16 * n
+	case polygonType:
+		rings, err := count(4)
+		if err != nil {
+			return 0, err
+		}
+		for i := 0; i < rings; i++ {
+			n, err := count(16)
+			if err != nil {
+				return 0, err
+			}
+			offset += 16 * n
+		}
+	case multiPointType, multiLineStringType, multiPolygonType, geometryCollectionType:
+		n, err := count(5)
+		if err != nil {
+			return 0, err
+		}
+		for i := 0; i < n; i++ {
+			if len(data) <= offset {
+				return 0, ErrNotWKB
+			}
+			data[offset] = mark
+			l, err := markEntities(data[offset:], order, mark)
+			if err != nil {
+				return 0, err
+			}
+			offset += l
+		}
+	default:
+		return 0, ErrUnsupportedGeometry
+	}
+
+	if offset > len(data) {
+		return 0, ErrNotWKB
+	}
+	return offset, nil
+}
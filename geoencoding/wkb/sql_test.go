@@ -0,0 +1,149 @@
+package wkb
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+func TestEWKBGeometry(t *testing.T) {
+	cases := []struct {
+		name string
+		data interface{}
+		geom space.Geometry
+		srid int
+	}{
+		{
+			name: "hex ewkb with srid",
+			data: []byte("0101000020E6100000000000000000F03F0000000000000040"),
+			geom: space.Point{1, 2},
+			srid: space.WGS84,
+		},
+		{
+			name: "binary ewkb with srid",
+			data: HexToBytes("0101000020110F0000000000000000F03F0000000000000040"),
+			geom: space.Point{1, 2},
+			srid: space.PseudoMercator,
+		},
+		{
+			name: "wkb without srid",
+			data: "\\x0101000000000000000000F03F0000000000000040",
+			geom: space.Point{1, 2},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var g EWKBGeometry
+			if err := g.Scan(tc.data); err != nil {
+				t.Fatalf("scan error: %v", err)
+			}
+			if !g.Valid || g.SRID != tc.srid || !g.Geometry.Equals(tc.geom) {
+				t.Errorf("incorrect scan: %v, %v", g.SRID, g.Geometry)
+			}
+			if g.Geometry.CoordinateSystem() != tc.srid && tc.srid != 0 {
+				t.Errorf("incorrect coordinate system: %v != %v", g.Geometry.CoordinateSystem(), tc.srid)
+			}
+		})
+	}
+
+	var g EWKBGeometry
+	if err := g.Scan(nil); err != nil || g.Valid {
+		t.Errorf("should noop for nil data: %v", err)
+	}
+}
+
+func TestEWKBGeometry_Value(t *testing.T) {
+	geom, _ := space.CreateElementValidWithCoordSys(space.Point{1, 2}, space.WGS84)
+	val, err := EWKBGeometry{Geometry: geom}.Value()
+	if err != nil {
+		t.Fatalf("value error: %v", err)
+	}
+	if expected := "0101000020e6100000000000000000f03f0000000000000040"; hex.EncodeToString(val.([]byte)) != expected {
+		t.Errorf("incorrect ewkb: %x != %v", val, expected)
+	}
+
+	poly := space.MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}, {{{2, 2}, {3, 2}, {3, 3}, {2, 2}}}}
+	val, err = EWKBGeometry{Geometry: poly, SRID: space.PseudoMercator}.Value()
+	if err != nil {
+		t.Fatalf("value error: %v", err)
+	}
+	var g EWKBGeometry
+	if err := g.Scan(val); err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+	if g.SRID != space.PseudoMercator || !g.Geometry.Equals(poly) {
+		t.Errorf("incorrect round trip: %v, %v", g.SRID, g.Geometry)
+	}
+
+	if val, err := (EWKBGeometry{}).Value(); val != nil || err != nil {
+		t.Errorf("should be NULL: %v, %v", val, err)
+	}
+}
+
+func TestMySQLGeometry(t *testing.T) {
+	var g MySQLGeometry
+	if err := g.Scan(append([]byte{230, 16, 0, 0}, testPointData...)); err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+	if !g.Valid || g.SRID != space.WGS84 || !g.Geometry.Equals(testPoint) {
+		t.Errorf("incorrect scan: %v, %v", g.SRID, g.Geometry)
+	}
+	if g.Geometry.CoordinateSystem() != space.WGS84 {
+		t.Errorf("incorrect coordinate system: %v", g.Geometry.CoordinateSystem())
+	}
+
+	val, err := g.Value()
+	if err != nil {
+		t.Fatalf("value error: %v", err)
+	}
+	if !bytes.Equal(val.([]byte), append([]byte{230, 16, 0, 0}, testPointData...)) {
+		t.Errorf("incorrect value: %v", val)
+	}
+}
+
+func TestSpatiaLiteGeometry(t *testing.T) {
+	data := HexToBytes("0001E6100000" +
+		"000000000000F03F" + "0000000000000040" + "000000000000F03F" + "0000000000000040" + "7C" +
+		"01000000" + "000000000000F03F" + "0000000000000040" + "FE")
+
+	var g SpatiaLiteGeometry
+	if err := g.Scan(data); err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+	if !g.Valid || g.SRID != space.WGS84 || !g.Geometry.Equals(space.Point{1, 2}) {
+		t.Errorf("incorrect scan: %v, %v", g.SRID, g.Geometry)
+	}
+	val, err := g.Value()
+	if err != nil {
+		t.Fatalf("value error: %v", err)
+	}
+	if !bytes.Equal(val.([]byte), data) {
+		t.Errorf("incorrect value: %x != %x", val, data)
+	}
+
+	coll := space.Collection{
+		space.Point{1, 2},
+		space.MultiLineString{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}}},
+	}
+	val, err = SpatiaLiteGeometry{Geometry: coll, SRID: space.WGS84}.Value()
+	if err != nil {
+		t.Fatalf("value error: %v", err)
+	}
+	blob := val.([]byte)
+	// the nested entities start with the entity mark.
+	if blob[spatiaLiteHeaderLength+8] != spatiaLiteEntity {
+		t.Errorf("nested entity should start with the mark: %x", blob)
+	}
+	if err := g.Scan(blob); err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+	if !g.Geometry.Equals(coll) {
+		t.Errorf("incorrect round trip: %v", g.Geometry)
+	}
+
+	if err := g.Scan(testPointData); err != ErrNotWKB {
+		t.Errorf("should not scan wkb: %v", err)
+	}
+}
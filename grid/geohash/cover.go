@@ -0,0 +1,139 @@
+package geohash
+
+import (
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/topograph"
+)
+
+// candidate is a cell that intersects the geometry.
+type candidate struct {
+	hash    string
+	covered bool
+}
+
+// coverer computes the cover of geometry.
+type coverer struct {
+	geom  space.Geometry
+	bound space.Bound
+	topog topograph.Relationship
+}
+
+// Cover returns the minimal set of geohashes that covers the geometry, the geohashes
+// are not longer than maxPrecision. The cells that are covered by the geometry are not subdivided,
+// the cells on the boundary are subdivided until maxPrecision is reached,
+// or until the number of geohashes would exceed maxCells. maxCells <= 0 means no limit.
+// The geohashes are ordered from the coarse to the fine.
+func Cover(geom space.Geometry, maxPrecision, maxCells int) ([]string, error) {
+	if maxPrecision < 1 || maxPrecision > MaxPrecision {
+		return nil, ErrInvalidPrecision
+	}
+	if geom == nil || geom.IsEmpty() {
+		return nil, nil
+	}
+	c := &coverer{geom: geom, bound: geom.Bound(), topog: topograph.NormalRelationship()}
+
+	queue, err := c.initialCandidates(maxPrecision)
+	if err != nil {
+		return nil, err
+	}
+	result := []string{}
+	for len(queue) > 0 {
+		cand := queue[0]
+		queue = queue[1:]
+		if cand.covered || len(cand.hash) >= maxPrecision {
+			result = append(result, cand.hash)
+			continue
+		}
+
+		children, err := c.children(cand.hash)
+		if err != nil {
+			return nil, err
+		}
+		if len(children) == 0 ||
+			(maxCells > 0 && len(result)+len(queue)+len(children) > maxCells) {
+			result = append(result, cand.hash)
+			continue
+		}
+		queue = append(queue, children...)
+	}
+	return result, nil
+}
+
+// initialCandidates returns the smallest cell that contains the bound of geometry,
+// or the top level cells that intersect the geometry if the bound crosses them.
+func (c *coverer) initialCandidates(maxPrecision int) ([]candidate, error) {
+	minHash, err := Encode(c.bound.Min, maxPrecision)
+	if err != nil {
+		return nil, err
+	}
+	maxHash, err := Encode(c.bound.Max, maxPrecision)
+	if err != nil {
+		return nil, err
+	}
+	prefix := 0
+	for prefix < len(minHash) && minHash[prefix] == maxHash[prefix] {
+		prefix++
+	}
+	if prefix == 0 {
+		return c.children("")
+	}
+	cand, ok, err := c.classify(minHash[:prefix])
+	if err != nil || !ok {
+		return nil, err
+	}
+	return []candidate{cand}, nil
+}
+
+// children returns the sub cells of hash that intersect the geometry.
+func (c *coverer) children(hash string) ([]candidate, error) {
+	hashes, err := Children(hash)
+	if err != nil {
+		return nil, err
+	}
+	children := []candidate{}
+	for _, v := range hashes {
+		cand, ok, err := c.classify(v)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			children = append(children, cand)
+		}
+	}
+	return children, nil
+}
+
+// classify returns the candidate of the cell, ok is false if the cell does not intersect the geometry.
+// A cell is kept for an areal geometry only if the interiors intersect,
+// so the cells that just touch the geometry are not used.
+func (c *coverer) classify(hash string) (cand candidate, ok bool, err error) {
+	bound, err := Decode(hash)
+	if err != nil {
+		return
+	}
+	if !bound.IntersectsBound(c.bound) {
+		return
+	}
+	if c.geom.Dimensions() == 2 && !overlapsBound(bound, c.bound) {
+		// the cell just touches the bound of geometry.
+		return
+	}
+	im, err := c.topog.Relate(bound.ToPolygon(), c.geom)
+	if err != nil || len(im) < 9 {
+		return
+	}
+	if c.geom.Dimensions() == 2 {
+		ok = im[0] != 'F'
+	} else {
+		ok = im[0] != 'F' || im[1] != 'F' || im[3] != 'F' || im[4] != 'F'
+	}
+	// the interior and the boundary of cell do not intersect the exterior of geometry.
+	cand = candidate{hash: hash, covered: im[2] == 'F' && im[5] == 'F'}
+	return
+}
+
+// overlapsBound returns true if the interiors of the bounds intersect.
+func overlapsBound(b, other space.Bound) bool {
+	return b.Min.X() < other.Max.X() && other.Min.X() < b.Max.X() &&
+		b.Min.Y() < other.Max.Y() && other.Min.Y() < b.Max.Y()
+}
@@ -0,0 +1,202 @@
+// Package geohash encodes the points into geohash strings and decodes geohash strings into bounds.
+// It also finds the neighbors of a geohash and covers a geometry by geohashes.
+package geohash
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+// MaxPrecision is the max length of geohash, 12 characters locate a cell about 3.7cm x 1.9cm.
+const MaxPrecision = 12
+
+// base32 is the geohash alphabet.
+const base32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// ErrInvalidPrecision ...
+var ErrInvalidPrecision = fmt.Errorf("geohash precision should be in range [1, %d]", MaxPrecision)
+
+// ErrInvalidHash ...
+var ErrInvalidHash = fmt.Errorf("geohash is invalid")
+
+// Direction is the direction of a neighbor.
+type Direction int
+
+// const directions, clockwise from north.
+const (
+	North Direction = iota
+	NorthEast
+	East
+	SouthEast
+	South
+	SouthWest
+	West
+	NorthWest
+)
+
+// offsets of the directions in cells, dx dy.
+var directionOffsets = [8][2]float64{
+	{0, 1}, {1, 1}, {1, 0}, {1, -1}, {0, -1}, {-1, -1}, {-1, 0}, {-1, 1},
+}
+
+var decodeMap [256]int8
+
+func init() {
+	for i := range decodeMap {
+		decodeMap[i] = -1
+	}
+	for i := 0; i < len(base32); i++ {
+		decodeMap[base32[i]] = int8(i)
+		decodeMap[strings.ToUpper(base32)[i]] = int8(i)
+	}
+}
+
+// Encode returns the geohash of the point with the precision, the precision is the length of geohash.
+func Encode(point space.Point, precision int) (string, error) {
+	if precision < 1 || precision > MaxPrecision {
+		return "", ErrInvalidPrecision
+	}
+	if !point.IsCorrect() {
+		return "", ErrInvalidHash
+	}
+	lon, lat := point.Lon(), point.Lat()
+	if lon < -180 || lon > 180 || lat < -90 || lat > 90 {
+		return "", fmt.Errorf("geohash point is out of range: %v", point)
+	}
+
+	minLon, maxLon := -180.0, 180.0
+	minLat, maxLat := -90.0, 90.0
+	hash := make([]byte, precision)
+	evenBit := true
+	for i := 0; i < precision; i++ {
+		idx := 0
+		for bit := 0; bit < 5; bit++ {
+			idx <<= 1
+			if evenBit {
+				mid := (minLon + maxLon) / 2
+				if lon >= mid {
+					idx |= 1
+					minLon = mid
+				} else {
+					maxLon = mid
+				}
+			} else {
+				mid := (minLat + maxLat) / 2
+				if lat >= mid {
+					idx |= 1
+					minLat = mid
+				} else {
+					maxLat = mid
+				}
+			}
+			evenBit = !evenBit
+		}
+		hash[i] = base32[idx]
+	}
+	return string(hash), nil
+}
+
+// Decode returns the bound of the cell that the geohash locates.
+func Decode(hash string) (space.Bound, error) {
+	if len(hash) < 1 || len(hash) > MaxPrecision {
+		return space.Bound{}, ErrInvalidHash
+	}
+
+	minLon, maxLon := -180.0, 180.0
+	minLat, maxLat := -90.0, 90.0
+	evenBit := true
+	for i := 0; i < len(hash); i++ {
+		idx := decodeMap[hash[i]]
+		if idx < 0 {
+			return space.Bound{}, ErrInvalidHash
+		}
+		for bit := 4; bit >= 0; bit-- {
+			isSet := (idx>>uint(bit))&1 == 1
+			if evenBit {
+				mid := (minLon + maxLon) / 2
+				if isSet {
+					minLon = mid
+				} else {
+					maxLon = mid
+				}
+			} else {
+				mid := (minLat + maxLat) / 2
+				if isSet {
+					minLat = mid
+				} else {
+					maxLat = mid
+				}
+			}
+			evenBit = !evenBit
+		}
+	}
+	return space.Bound{Min: space.Point{minLon, minLat}, Max: space.Point{maxLon, maxLat}}, nil
+}
+
+// Center returns the center point of the cell that the geohash locates.
+func Center(hash string) (space.Point, error) {
+	bound, err := Decode(hash)
+	if err != nil {
+		return nil, err
+	}
+	return space.Point{(bound.Min.X() + bound.Max.X()) / 2, (bound.Min.Y() + bound.Max.Y()) / 2}, nil
+}
+
+// Neighbor returns the adjacent geohash in the direction with the same precision.
+// The longitude wraps around the antimeridian, returns an empty string beyond the poles.
+func Neighbor(hash string, direction Direction) (string, error) {
+	if direction < North || direction > NorthWest {
+		return "", fmt.Errorf("geohash direction is invalid: %v", direction)
+	}
+	bound, err := Decode(hash)
+	if err != nil {
+		return "", err
+	}
+	width := bound.Max.X() - bound.Min.X()
+	height := bound.Max.Y() - bound.Min.Y()
+	offset := directionOffsets[direction]
+
+	lon := (bound.Min.X()+bound.Max.X())/2 + offset[0]*width
+	lat := (bound.Min.Y()+bound.Max.Y())/2 + offset[1]*height
+	if lat > 90 || lat < -90 {
+		return "", nil
+	}
+	if lon > 180 {
+		lon -= 360
+	} else if lon < -180 {
+		lon += 360
+	}
+	return Encode(space.Point{lon, lat}, len(hash))
+}
+
+// Neighbors returns the 8 adjacent geohashes, ordered clockwise from north as
+// North, NorthEast, East, SouthEast, South, SouthWest, West, NorthWest.
+// The neighbors beyond the poles are empty strings.
+func Neighbors(hash string) ([]string, error) {
+	neighbors := make([]string, 0, 8)
+	for d := North; d <= NorthWest; d++ {
+		neighbor, err := Neighbor(hash, d)
+		if err != nil {
+			return nil, err
+		}
+		neighbors = append(neighbors, neighbor)
+	}
+	return neighbors, nil
+}
+
+// Children returns the 32 geohashes that subdivide the geohash.
+func Children(hash string) ([]string, error) {
+	if len(hash) >= MaxPrecision {
+		return nil, ErrInvalidPrecision
+	}
+	if _, err := Decode(hash); err != nil && hash != "" {
+		return nil, err
+	}
+	children := make([]string, len(base32))
+	for i := 0; i < len(base32); i++ {
+		children[i] = hash + string(base32[i])
+	}
+	return children, nil
+}
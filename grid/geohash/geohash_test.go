@@ -0,0 +1,137 @@
+package geohash
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+func TestEncode(t *testing.T) {
+	cases := []struct {
+		name      string
+		point     space.Point
+		precision int
+		want      string
+		wantErr   bool
+	}{
+		{name: "precision 5", point: space.Point{-5.6, 42.6}, precision: 5, want: "ezs42"},
+		{name: "precision 11", point: space.Point{10.40744, 57.64911}, precision: 11, want: "u4pruydqqvj"},
+		{name: "invalid precision", point: space.Point{-5.6, 42.6}, precision: 13, wantErr: true},
+		{name: "out of range", point: space.Point{190, 42.6}, precision: 5, wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Encode(tc.point, tc.precision)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Encode() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("Encode() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	bound, err := Decode("ezs42")
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	want := space.Bound{Min: space.Point{-5.625, 42.5830078125}, Max: space.Point{-5.5810546875, 42.626953125}}
+	if !bound.Equals(want) {
+		t.Errorf("Decode() = %v, want %v", bound, want)
+	}
+	if !bound.Contains(space.Point{-5.6, 42.6}) {
+		t.Errorf("Decode() %v should contain the encoded point", bound)
+	}
+
+	for _, hash := range []string{"", "ezs4a", "0123456789bcd"} {
+		if _, err := Decode(hash); err != ErrInvalidHash {
+			t.Errorf("Decode(%q) error = %v, want %v", hash, err, ErrInvalidHash)
+		}
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	cases := []struct {
+		name string
+		hash string
+		want []string
+	}{
+		{name: "normal", hash: "ezs42", want: []string{"ezs48", "ezs49", "ezs43", "ezs41", "ezs40", "ezefp", "ezefr", "ezefx"}},
+		{name: "antimeridian", hash: "2", want: []string{"8", "9", "3", "1", "0", "p", "r", "x"}},
+		{name: "pole", hash: "b", want: []string{"", "", "c", "9", "8", "x", "z", ""}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Neighbors(tc.hash)
+			if err != nil {
+				t.Fatalf("Neighbors() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Neighbors() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCover(t *testing.T) {
+	bound, _ := Decode("wx4g")
+	polygon := space.Polygon{{
+		{bound.Min.X() + 0.01, bound.Min.Y() + 0.01},
+		{bound.Max.X() - 0.01, bound.Min.Y() + 0.01},
+		{bound.Max.X() - 0.01, bound.Max.Y() - 0.01},
+		{bound.Min.X() + 0.01, bound.Max.Y() - 0.01},
+		{bound.Min.X() + 0.01, bound.Min.Y() + 0.01},
+	}}
+
+	cases := []struct {
+		name         string
+		geom         space.Geometry
+		maxPrecision int
+		maxCells     int
+		want         []string
+	}{
+		{name: "coarse", geom: polygon, maxPrecision: 4, want: []string{"wx4g"}},
+		{name: "max cells", geom: polygon, maxPrecision: 6, maxCells: 20, want: []string{"wx4g"}},
+		{name: "point", geom: space.Point{-5.6, 42.6}, maxPrecision: 5, want: []string{"ezs42"}},
+		{name: "cell", geom: bound.ToPolygon(), maxPrecision: 8, want: []string{"wx4g"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Cover(tc.geom, tc.maxPrecision, tc.maxCells)
+			if err != nil {
+				t.Fatalf("Cover() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Cover() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	hashes, err := Cover(polygon, 5, 0)
+	if err != nil {
+		t.Fatalf("Cover() error = %v", err)
+	}
+	area := 0.0
+	for _, hash := range hashes {
+		if len(hash) > 5 {
+			t.Errorf("Cover() hash %v is longer than max precision", hash)
+		}
+		b, _ := Decode(hash)
+		a, _ := b.Area()
+		area += a
+	}
+	polygonArea, _ := polygon.Area()
+	if cellArea, _ := bound.Area(); area < polygonArea || area > cellArea {
+		t.Errorf("Cover() area = %v, want in [%v, %v]", area, polygonArea, cellArea)
+	}
+	if len(hashes) <= 1 {
+		t.Errorf("Cover() should subdivide the cells on the boundary: %v", hashes)
+	}
+
+	if _, err := Cover(polygon, 0, 0); err != ErrInvalidPrecision {
+		t.Errorf("Cover() error = %v, want %v", err, ErrInvalidPrecision)
+	}
+}
@@ -4,6 +4,7 @@ package intervalrtree
 import (
 	"log"
 	"sort"
+	"sync"
 
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
 	"github.com/spatial-go/geoos/index"
@@ -16,9 +17,11 @@ import (
 //	where the range is an interval of the real line (which may be a single point).
 //	A common use is to index 1-dimensional intervals which
 //	are the projection of 2-D objects onto an axis of the coordinate system.
+//	The tree is built by the first query, so it is safe to query it concurrently once the items are inserted.
 type SortedPackedIntervalRTree struct {
 	leaves LeafNodes
 	root   Node
+	mu     sync.Mutex
 }
 
 // Insert Adds an item to the index which is associated with the given interval
@@ -33,6 +36,8 @@ func (s *SortedPackedIntervalRTree) Insert(queryEnv *envelope.Envelope, item int
 }
 
 func (s *SortedPackedIntervalRTree) init() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// already built
 	if s.root != nil {
 		return
@@ -61,32 +66,22 @@ func (s *SortedPackedIntervalRTree) buildTree() Node {
 
 	// now group nodes into blocks of two and build tree up recursively
 	src := s.leaves
-	var dest = make(LeafNodes, 1)
-	for {
-		s.buildLevel(src, dest)
-		if len(dest) == 1 {
-			return dest[0]
-		}
-		temp := src
-		src = dest
-		dest = temp
+	for len(src) > 1 {
+		src = s.buildLevel(src)
 	}
+	return src[0]
 }
 
-func (s *SortedPackedIntervalRTree) buildLevel(src, dest LeafNodes) {
-	//level++;
+func (s *SortedPackedIntervalRTree) buildLevel(src LeafNodes) LeafNodes {
+	dest := make(LeafNodes, 0, (len(src)+1)/2)
 	for i := 0; i < len(src); i += 2 {
-		if i == 0 {
-			dest[i] = src[i]
+		if i+1 < len(src) {
+			dest = append(dest, NewBranchNode(src[i], src[i+1]))
 		} else {
 			dest = append(dest, src[i])
 		}
-		if i+1 < len(src) {
-			node := NewBranchNode(src[i], src[i+1])
-			dest = append(dest, node)
-
-		}
 	}
+	return dest
 }
 
 // Query Search for intervals in the index which intersect the given closed interval and apply the visitor to them.
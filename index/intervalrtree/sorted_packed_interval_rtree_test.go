@@ -84,3 +84,29 @@ func TestSortedPackedIntervalRTree_QueryVisitor(t *testing.T) {
 		})
 	}
 }
+
+func TestSortedPackedIntervalRTree_Query(t *testing.T) {
+	tree := &SortedPackedIntervalRTree{}
+	for i := 0; i < 10; i++ {
+		if err := tree.Insert(envelope.FourFloat(float64(i), float64(i)+0.5, 0, 0), i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name     string
+		queryEnv *envelope.Envelope
+		want     int
+	}{
+		{"query all", envelope.FourFloat(-1, 10, 0, 0), 10},
+		{"query some", envelope.FourFloat(3, 6, 0, 0), 4},
+		{"query point", envelope.FourFloat(7.5, 7.5, 0, 0), 1},
+		{"query gap", envelope.FourFloat(7.6, 7.9, 0, 0), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tree.Query(tt.queryEnv).([]interface{}); len(got) != tt.want {
+				t.Errorf("SortedPackedIntervalRTree.Query() = %v, want %v items", got, tt.want)
+			}
+		})
+	}
+}
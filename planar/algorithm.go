@@ -4,12 +4,18 @@ package planar
 import (
 	"errors"
 
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/operation"
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
 )
 
 // ErrNotPolygon UnaryUnion parameter is not polygon
 var ErrNotPolygon = errors.New("Geometry is not polygon")
 
+// PolygonizeResult is the result of Polygonize.
+type PolygonizeResult = operation.PolygonizeResult
+
 // Algorithm is the interface implemented by an object that can implementation
 // spatial algorithm.
 type Algorithm interface {
@@ -21,12 +27,44 @@ type Algorithm interface {
 
 	BufferInMeter(geom space.Geometry, width float64, quadsegs int) space.Geometry
 
+	BufferWithParams(geom space.Geometry, width float64, params *buffer.CurveParameters) space.Geometry
+
+	VariableBuffer(geom space.Geometry, startDistance, endDistance float64, quadsegs int) (space.Geometry, error)
+
+	VariableBufferDistances(geom space.Geometry, distances []float64, quadsegs int) (space.Geometry, error)
+
+	VariableBufferInMeter(geom space.Geometry, startDistance, endDistance float64, quadsegs int) (space.Geometry, error)
+
+	VariableBufferDistancesInMeter(geom space.Geometry, distances []float64, quadsegs int) (space.Geometry, error)
+
 	Centroid(geom space.Geometry) (space.Geometry, error)
 
 	Contains(geom1, geom2 space.Geometry) (bool, error)
 
+	ContainsProperly(geom1, geom2 space.Geometry) (bool, error)
+
 	ConvexHull(geom space.Geometry) (space.Geometry, error)
 
+	ConcaveHull(geom space.Geometry, maxEdgeLength float64, holesAllowed bool) (space.Geometry, error)
+
+	ConcaveHullByLengthRatio(geom space.Geometry, lengthRatio float64, holesAllowed bool) (space.Geometry, error)
+
+	AlphaShape(geom space.Geometry, alpha float64, holesAllowed bool) (space.Geometry, error)
+
+	MinimumBoundingCircle(geom space.Geometry) (*space.Circle, error)
+
+	MinimumRotatedRectangle(geom space.Geometry) (space.Geometry, error)
+
+	MinimumWidth(geom space.Geometry) (float64, error)
+
+	MinimumDiameter(geom space.Geometry) (space.Geometry, error)
+
+	MaximumInscribedCircle(geom space.Geometry, tolerance float64) (*space.Circle, error)
+
+	PoleOfInaccessibility(geom space.Geometry, tolerance float64) (space.Geometry, error)
+
+	LargestEmptyCircle(geom, boundary space.Geometry, tolerance float64) (*space.Circle, error)
+
 	CoveredBy(geom1, geom2 space.Geometry) (bool, error)
 
 	Covers(geom1, geom2 space.Geometry) (bool, error)
@@ -41,6 +79,14 @@ type Algorithm interface {
 
 	SphericalDistance(geom1, geom2 space.Geometry) (float64, error)
 
+	ShortestLine(geom1, geom2 space.Geometry) (space.Geometry, error)
+
+	ClosestPoints(geom1, geom2 space.Geometry) (space.Point, space.Point, error)
+
+	DWithin(geom1, geom2 space.Geometry, distance float64) (bool, error)
+
+	DWithinInMeter(geom1, geom2 space.Geometry, distance float64) (bool, error)
+
 	Envelope(geom space.Geometry) (space.Geometry, error)
 
 	Equals(geom1, geom2 space.Geometry) (bool, error)
@@ -51,6 +97,14 @@ type Algorithm interface {
 
 	HausdorffDistanceDensify(s, d space.Geometry, densifyFrac float64) (float64, error)
 
+	FrechetDistance(geom1, geom2 space.Geometry) (float64, error)
+
+	FrechetDistanceDensify(geom1, geom2 space.Geometry, densifyFrac float64) (float64, error)
+
+	DynamicTimeWarping(geom1, geom2 space.Geometry) (float64, error)
+
+	LCSSSimilarity(geom1, geom2 space.Geometry, epsilon float64, delta int) (float64, error)
+
 	Intersection(geom1, geom2 space.Geometry) (space.Geometry, error)
 
 	Intersects(geom1, geom2 space.Geometry) (bool, error)
@@ -63,24 +117,46 @@ type Algorithm interface {
 
 	IsSimple(geom space.Geometry) (bool, error)
 
+	IsValidReason(geom space.Geometry) (string, error)
+
+	IsValidDetail(geom space.Geometry) (*space.ValidError, error)
+
 	Length(geom space.Geometry) (float64, error)
 
 	LineMerge(geom space.Geometry) (space.Geometry, error)
 
+	MakeValid(geom space.Geometry, method int) (space.Geometry, error)
+
+	Polygonize(geom space.Geometry) (*PolygonizeResult, error)
+
+	BuildArea(geom space.Geometry) (space.Geometry, error)
+
+	Split(geom, blade space.Geometry) (space.Collection, error)
+
 	NGeometry(geom space.Geometry) (int, error)
 
 	Overlaps(geom1, geom2 space.Geometry) (bool, error)
 
 	PointOnSurface(geom space.Geometry) (space.Geometry, error)
 
+	Prepare(geom space.Geometry) (*PreparedGeometry, error)
+
 	Relate(s, d space.Geometry) (string, error)
 
+	RelateBoundaryNodeRule(s, d space.Geometry, rule relate.BoundaryNodeRule) (string, error)
+
+	RelatePattern(s, d space.Geometry, pattern string) (bool, error)
+
 	SharedPaths(geom1, geom2 space.Geometry) (string, error)
 
 	Simplify(geom space.Geometry, tolerance float64) (space.Geometry, error)
 
 	SimplifyP(geom space.Geometry, tolerance float64) (space.Geometry, error)
 
+	Densify(geom space.Geometry, maxSegmentLength float64) (space.Geometry, error)
+
+	SegmentizeInMeter(geom space.Geometry, maxSegmentLength float64) (space.Geometry, error)
+
 	Snap(input, reference space.Geometry, tolerance float64) (space.Geometry, error)
 
 	SymDifference(geom1, geom2 space.Geometry) (space.Geometry, error)
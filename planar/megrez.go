@@ -2,6 +2,7 @@ package planar
 
 import (
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 	"github.com/spatial-go/geoos/space/topograph"
 )
 
@@ -43,6 +44,23 @@ func (g *megrezAlgorithm) IsRing(geom space.Geometry) (bool, error) {
 	return elem.IsClosed() && elem.IsSimple(), nil
 }
 
+// IsValidReason returns the reason why the geometry is invalid with the location,
+// e.g. "Self-intersection[1 1]", or "Valid Geometry" if it is valid.
+func (g *megrezAlgorithm) IsValidReason(geom space.Geometry) (string, error) {
+	if geom == nil {
+		return "", spaceerr.ErrNilGeometry
+	}
+	return geom.IsValidReason(), nil
+}
+
+// IsValidDetail returns the kind and location of the invalidity, returns nil if the geometry is valid.
+func (g *megrezAlgorithm) IsValidDetail(geom space.Geometry) (*space.ValidError, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return geom.IsValidDetail(), nil
+}
+
 // IsSimple returns true if this space.Geometry has no anomalous geometric points, such as self intersection or self tangency.
 func (g *megrezAlgorithm) IsSimple(geom space.Geometry) (bool, error) {
 	return geom.IsSimple(), nil
@@ -3,6 +3,7 @@ package planar
 import (
 	"github.com/spatial-go/geoos/algorithm/measure"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 // Area returns the area of a polygonal geometry.
@@ -28,6 +29,46 @@ func (g *megrezAlgorithm) SphericalDistance(geom1, geom2 space.Geometry) (float6
 	return geom1.SpheroidDistance(geom2)
 }
 
+// ShortestLine returns the 2-point LineString from geom1 to geom2 of the minimum planar distance between them,
+// both points are the same one if the geometries intersect.
+func (g *megrezAlgorithm) ShortestLine(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	p1, p2, err := g.ClosestPoints(geom1, geom2)
+	if err != nil {
+		return nil, err
+	}
+	return space.LineString{p1, p2}, nil
+}
+
+// ClosestPoints returns the point of geom1 and the point of geom2 of the minimum planar distance between them.
+func (g *megrezAlgorithm) ClosestPoints(geom1, geom2 space.Geometry) (space.Point, space.Point, error) {
+	if geom1 == nil || geom1.IsEmpty() || geom2 == nil || geom2.IsEmpty() {
+		return nil, nil, spaceerr.ErrNilGeometry
+	}
+	_, points := measure.ClosestPoints(geom1.ToMatrix(), geom2.ToMatrix())
+	if points == nil {
+		return nil, nil, spaceerr.ErrNilGeometry
+	}
+	return space.Point(points[0]), space.Point(points[1]), nil
+}
+
+// DWithin returns true if the planar distance between two geometries is within distance,
+// it stops once any parts of geometries are found within distance rather than computing the distance.
+func (g *megrezAlgorithm) DWithin(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	if geom1 == nil || geom1.IsEmpty() || geom2 == nil || geom2.IsEmpty() {
+		return false, spaceerr.ErrNilGeometry
+	}
+	return measure.DWithin(geom1.ToMatrix(), geom2.ToMatrix(), distance), nil
+}
+
+// DWithinInMeter returns true if the spherical distance between two geometries of longitude and latitude
+// is within distance in meter.
+func (g *megrezAlgorithm) DWithinInMeter(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	if geom1 == nil || geom1.IsEmpty() || geom2 == nil || geom2.IsEmpty() {
+		return false, spaceerr.ErrNilGeometry
+	}
+	return measure.DWithinInMeter(geom1.ToMatrix(), geom2.ToMatrix(), distance), nil
+}
+
 // HausdorffDistance returns the Hausdorff distance between two geometries, a measure of how similar
 // or dissimilar 2 geometries are. Implements algorithm for computing a distance metric which can be
 // thought of as the "Discrete Hausdorff Distance". This is the Hausdorff distance restricted
@@ -41,6 +82,43 @@ func (g *megrezAlgorithm) HausdorffDistanceDensify(geom1, geom2 space.Geometry,
 	return (&measure.HausdorffDistance{}).DistanceDensifyFrac(geom1.ToMatrix(), geom2.ToMatrix(), densifyFrac)
 }
 
+// FrechetDistance returns the discrete Fréchet distance between two geometries, which compares the vertices
+// of geometries in order, so it measures the similarity of the tracks with their directions.
+func (g *megrezAlgorithm) FrechetDistance(geom1, geom2 space.Geometry) (float64, error) {
+	if geom1 == nil || geom1.IsEmpty() || geom2 == nil || geom2.IsEmpty() {
+		return 0, spaceerr.ErrNilGeometry
+	}
+	return (&measure.FrechetDistance{}).Distance(geom1.ToMatrix(), geom2.ToMatrix()), nil
+}
+
+// FrechetDistanceDensify computes the discrete Fréchet distance with an additional densification fraction amount,
+// each segment is divided into the parts of the fraction of its length.
+func (g *megrezAlgorithm) FrechetDistanceDensify(geom1, geom2 space.Geometry, densifyFrac float64) (float64, error) {
+	if geom1 == nil || geom1.IsEmpty() || geom2 == nil || geom2.IsEmpty() {
+		return 0, spaceerr.ErrNilGeometry
+	}
+	return (&measure.FrechetDistance{}).DistanceDensifyFrac(geom1.ToMatrix(), geom2.ToMatrix(), densifyFrac)
+}
+
+// DynamicTimeWarping returns the dynamic time warping distance between two geometries,
+// the smallest sum of the distances of the vertices matched in order.
+func (g *megrezAlgorithm) DynamicTimeWarping(geom1, geom2 space.Geometry) (float64, error) {
+	if geom1 == nil || geom1.IsEmpty() || geom2 == nil || geom2.IsEmpty() {
+		return 0, spaceerr.ErrNilGeometry
+	}
+	return measure.DynamicTimeWarping(geom1.ToMatrix(), geom2.ToMatrix()), nil
+}
+
+// LCSSSimilarity returns the similarity of the longest common subsequence of the vertices of two geometries,
+// the vertices match if they are within epsilon and their indexes differ by delta at most, a negative delta means no limit.
+// The similarity is in the range [0, 1].
+func (g *megrezAlgorithm) LCSSSimilarity(geom1, geom2 space.Geometry, epsilon float64, delta int) (float64, error) {
+	if geom1 == nil || geom1.IsEmpty() || geom2 == nil || geom2.IsEmpty() {
+		return 0, spaceerr.ErrNilGeometry
+	}
+	return measure.LCSSSimilarity(geom1.ToMatrix(), geom2.ToMatrix(), epsilon, delta), nil
+}
+
 // Length returns the 2D Cartesian length of the geometry if it is a LineString, MultiLineString
 func (g *megrezAlgorithm) Length(geom space.Geometry) (float64, error) {
 	return geom.Length(), nil
@@ -5,6 +5,7 @@ import (
 
 	"github.com/spatial-go/geoos/geoencoding/wkt"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func TestAlgorithm_Area(t *testing.T) {
@@ -124,6 +125,84 @@ func TestAlgorithm_SphericalDistance(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_ShortestLine(t *testing.T) {
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0, 10 0, 10 10, 0 10, 0 0))`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(12 5, 15 8)`)
+	point, _ := wkt.UnmarshalString(`POINT(5 5)`)
+	tests := []struct {
+		name    string
+		g1      space.Geometry
+		g2      space.Geometry
+		want    space.Geometry
+		wantErr bool
+	}{
+		{name: "polygon line", g1: polygon, g2: line, want: space.LineString{{10, 5}, {12, 5}}},
+		{name: "line polygon", g1: line, g2: polygon, want: space.LineString{{12, 5}, {10, 5}}},
+		{name: "point in polygon", g1: point, g2: polygon, want: space.LineString{{5, 5}, {5, 5}}},
+		{name: "empty", g1: point, g2: space.LineString{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.ShortestLine(tt.g1, tt.g2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ShortestLine() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("ShortestLine() got = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			if p1, p2, _ := G.ClosestPoints(tt.g1, tt.g2); !(space.LineString{p1, p2}).Equals(got) {
+				t.Errorf("ClosestPoints() got = %v %v, want %v", p1, p2, got)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_DWithin(t *testing.T) {
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0, 10 0, 10 10, 0 10, 0 0))`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(12 5, 15 8)`)
+	point01 := space.Point{116.397439, 39.909177}
+	point02 := space.Point{116.397725, 39.903079}
+	tests := []struct {
+		name     string
+		g1       space.Geometry
+		g2       space.Geometry
+		distance float64
+		inMeter  bool
+		want     bool
+		wantErr  bool
+	}{
+		{name: "within", g1: polygon, g2: line, distance: 2, want: true},
+		{name: "beyond", g1: polygon, g2: line, distance: 1.5, want: false},
+		{name: "within in meter", g1: point01, g2: point02, distance: 700, inMeter: true, want: true},
+		{name: "beyond in meter", g1: point01, g2: point02, distance: 600, inMeter: true, want: false},
+		{name: "empty", g1: polygon, g2: space.Polygon{}, distance: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			var got bool
+			var err error
+			if tt.inMeter {
+				got, err = G.DWithinInMeter(tt.g1, tt.g2, tt.distance)
+			} else {
+				got, err = G.DWithin(tt.g1, tt.g2, tt.distance)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DWithin() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("DWithin() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAlgorithm_NGeometry(t *testing.T) {
 	multiPoint, _ := wkt.UnmarshalString(`MULTIPOINT ( -1 0, -1 2, -1 3, -1 4, -1 7, 0 1, 0 3, 1 1, 2 0, 6 0, 7 8, 9 8, 10 6 )`)
 	multiLineString, _ := wkt.UnmarshalString(`MULTILINESTRING((10 130,50 190,110 190,140 150,150 80,100 10,20 40,10 130),
@@ -251,3 +330,31 @@ func TestMegrezAlgorithm_HausdorffDistanceDensify(t *testing.T) {
 		})
 	}
 }
+
+func TestAlgorithm_TrajectorySimilarity(t *testing.T) {
+	track1, _ := wkt.UnmarshalString(`LINESTRING(0 0,1 0,2 0,3 0)`)
+	track2, _ := wkt.UnmarshalString(`LINESTRING(3 0,2 0,1 0,0 0)`)
+	G := NormalStrategy()
+
+	hausdorff, _ := G.HausdorffDistance(track1, track2)
+	frechet, err := G.FrechetDistance(track1, track2)
+	if err != nil {
+		t.Fatalf("FrechetDistance() error = %v", err)
+	}
+	// the tracks of opposite directions are the same to Hausdorff but not to Fréchet.
+	if hausdorff != 0 || frechet != 3 {
+		t.Errorf("HausdorffDistance() = %v, FrechetDistance() = %v, want 0 and 3", hausdorff, frechet)
+	}
+	if got, _ := G.FrechetDistanceDensify(track1, track1, 0.5); got != 0 {
+		t.Errorf("FrechetDistanceDensify() = %v, want 0", got)
+	}
+	if got, _ := G.DynamicTimeWarping(track1, track2); got != 8 {
+		t.Errorf("DynamicTimeWarping() = %v, want 8", got)
+	}
+	if got, _ := G.LCSSSimilarity(track1, track2, 0, -1); got != 0.25 {
+		t.Errorf("LCSSSimilarity() = %v, want 0.25", got)
+	}
+	if _, err := G.FrechetDistance(track1, space.LineString{}); err != spaceerr.ErrNilGeometry {
+		t.Errorf("FrechetDistance() error = %v, want %v", err, spaceerr.ErrNilGeometry)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/spatial-go/geoos/algorithm"
 	"github.com/spatial-go/geoos/algorithm/graph/clipping"
 	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/algorithm/overlay"
 	"github.com/spatial-go/geoos/algorithm/sharedpaths"
 	"github.com/spatial-go/geoos/geoencoding/wkt"
@@ -49,6 +50,107 @@ func (g *megrezAlgorithm) LineMerge(geom space.Geometry) (space.Geometry, error)
 	return lm, nil
 }
 
+// Polygonize returns the polygons formed from the linework of geometry,
+// along with the dangles, the cut edges and the invalid rings which do not form part of polygons.
+// The linework is noded at the intersections, each face enclosed by it becomes a polygon.
+func (g *megrezAlgorithm) Polygonize(geom space.Geometry) (*PolygonizeResult, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return operation.Polygonize(linework(geom.ToMatrix())), nil
+}
+
+// BuildArea returns the areal geometry formed from the linework of geometry,
+// the rings nested in a ring become its holes and the adjacent faces are merged.
+// The result is a Polygon or a MultiPolygon, it is the empty Polygon if the linework encloses no area.
+func (g *megrezAlgorithm) BuildArea(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return space.TransGeometry(operation.BuildArea(linework(geom.ToMatrix()))), nil
+}
+
+// Split returns a collection of the parts of geometry split by the blade,
+// the polygons are split by the lines and the lines are split by the points, the lines or the boundaries of polygons.
+// The parts of each polygon are ordered by their lowest point, the parts of each line are in the order along it,
+// and the parts of multi geometry follow the order of its elements.
+// The geometry itself is the only part if the blade does not cut it.
+func (g *megrezAlgorithm) Split(geom, blade space.Geometry) (space.Collection, error) {
+	if geom == nil || blade == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	result := space.Collection{}
+	switch geo := geom.Geom().(type) {
+	case space.Polygon, space.MultiPolygon:
+		switch blade.Geom().(type) {
+		case space.LineString, space.MultiLineString:
+		default:
+			return nil, spaceerr.ErrNotSupportGeometry
+		}
+		polys := []matrix.PolygonMatrix{}
+		switch m := geo.ToMatrix().(type) {
+		case matrix.PolygonMatrix:
+			polys = append(polys, m)
+		case matrix.MultiPolygonMatrix:
+			for _, v := range m {
+				polys = append(polys, v)
+			}
+		}
+		lines := linework(blade.ToMatrix())
+		for _, poly := range polys {
+			for _, part := range operation.SplitPolygon(poly, lines) {
+				result = append(result, space.Polygon(part))
+			}
+		}
+	case space.LineString, space.MultiLineString:
+		var points []matrix.Matrix
+		var lines []matrix.LineMatrix
+		switch blade.Geom().(type) {
+		case space.Point, space.MultiPoint:
+			points = matrix.TransMatrixes(blade.ToMatrix())
+		case space.LineString, space.MultiLineString, space.Polygon, space.MultiPolygon:
+			lines = linework(blade.ToMatrix())
+		default:
+			return nil, spaceerr.ErrNotSupportGeometry
+		}
+		for _, line := range linework(geo.ToMatrix()) {
+			for _, part := range operation.SplitLine(line, points, lines) {
+				result = append(result, space.LineString(part))
+			}
+		}
+	default:
+		return nil, spaceerr.ErrNotSupportGeometry
+	}
+	return result, nil
+}
+
+// linework returns the lines and the rings of geometry.
+func linework(m matrix.Steric) []matrix.LineMatrix {
+	switch mm := m.(type) {
+	case matrix.LineMatrix:
+		return []matrix.LineMatrix{mm}
+	case matrix.PolygonMatrix:
+		lines := []matrix.LineMatrix{}
+		for _, ring := range mm {
+			lines = append(lines, ring)
+		}
+		return lines
+	case matrix.MultiPolygonMatrix:
+		lines := []matrix.LineMatrix{}
+		for _, poly := range mm {
+			lines = append(lines, linework(matrix.PolygonMatrix(poly))...)
+		}
+		return lines
+	case matrix.Collection:
+		lines := []matrix.LineMatrix{}
+		for _, v := range mm {
+			lines = append(lines, linework(v)...)
+		}
+		return lines
+	}
+	return nil
+}
+
 // SharedPaths returns a collection containing paths shared by the two input geometries.
 // Those going in the same direction are in the first element of the collection,
 // those going in the opposite direction are in the second element.
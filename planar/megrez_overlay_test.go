@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/spatial-go/geoos"
+	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/geoencoding/wkt"
 	"github.com/spatial-go/geoos/space"
 )
@@ -87,6 +88,111 @@ func TestAlgorithm_Intersection(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_Polygonize(t *testing.T) {
+	lines, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,10 0,10 10,0 10,0 0),(5 -1,5 11),(10 10,12 12))`)
+	expectPolygons := []matrix.PolygonMatrix{
+		{{{0, 0}, {5, 0}, {5, 10}, {0, 10}, {0, 0}}},
+		{{{5, 0}, {10, 0}, {10, 10}, {5, 10}, {5, 0}}},
+	}
+	expectDangles := []matrix.LineMatrix{{{5, -1}, {5, 0}}, {{5, 10}, {5, 11}}, {{10, 10}, {12, 12}}}
+
+	G := NormalStrategy()
+	got, err := G.Polygonize(lines)
+	if err != nil {
+		t.Fatalf("GEOAlgorithm.Polygonize() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.Polygons, expectPolygons) {
+		t.Errorf("GEOAlgorithm.Polygonize() polygons = %v, want %v", got.Polygons, expectPolygons)
+	}
+	if !reflect.DeepEqual(got.Dangles, expectDangles) {
+		t.Errorf("GEOAlgorithm.Polygonize() dangles = %v, want %v", got.Dangles, expectDangles)
+	}
+	if len(got.CutEdges) != 0 || len(got.InvalidRings) != 0 {
+		t.Errorf("GEOAlgorithm.Polygonize() cut edges = %v, invalid rings = %v", got.CutEdges, got.InvalidRings)
+	}
+	if _, err := G.Polygonize(nil); err == nil {
+		t.Errorf("GEOAlgorithm.Polygonize() error = %v, wantErr %v", err, true)
+	}
+}
+
+func TestAlgorithm_BuildArea(t *testing.T) {
+	lines, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,10 0,10 10,0 10,0 0),(2 2,8 2,8 8,2 8,2 2),(4 4,6 4,6 6,4 6,4 4))`)
+	expectArea, _ := wkt.UnmarshalString(`MULTIPOLYGON(((0 0,10 0,10 10,0 10,0 0),(2 2,2 8,8 8,8 2,2 2)),((4 4,6 4,6 6,4 6,4 4)))`)
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+
+	type args struct {
+		g space.Geometry
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    space.Geometry
+		wantErr bool
+	}{
+		{name: "BuildArea nested", args: args{g: lines}, want: expectArea, wantErr: false},
+		{name: "BuildArea polygon", args: args{g: polygon}, want: polygon, wantErr: false},
+		{name: "BuildArea nil", args: args{g: nil}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			gotGeometry, err := G.BuildArea(tt.args.g)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GEOAlgorithm.BuildArea() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !gotGeometry.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("GEOAlgorithm.BuildArea() = %v, want %v", wkt.MarshalString(gotGeometry), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_Split(t *testing.T) {
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(0 0,10 0,10 10)`)
+	blade, _ := wkt.UnmarshalString(`LINESTRING(5 -1,5 11)`)
+	points, _ := wkt.UnmarshalString(`MULTIPOINT(5 0,10 5)`)
+	expectPolygons, _ := wkt.UnmarshalString(`GEOMETRYCOLLECTION(POLYGON((0 0,5 0,5 10,0 10,0 0)),POLYGON((5 0,10 0,10 10,5 10,5 0)))`)
+	expectLines, _ := wkt.UnmarshalString(`GEOMETRYCOLLECTION(LINESTRING(0 0,5 0),LINESTRING(5 0,10 0,10 5),LINESTRING(10 5,10 10))`)
+	expectLine, _ := wkt.UnmarshalString(`GEOMETRYCOLLECTION(LINESTRING(0 0,5 0),LINESTRING(5 0,10 0,10 10))`)
+
+	type args struct {
+		g     space.Geometry
+		blade space.Geometry
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    space.Geometry
+		wantErr bool
+	}{
+		{name: "Split polygon by line", args: args{g: polygon, blade: blade}, want: expectPolygons, wantErr: false},
+		{name: "Split line by points", args: args{g: line, blade: points}, want: expectLines, wantErr: false},
+		{name: "Split line by line", args: args{g: line, blade: blade}, want: expectLine, wantErr: false},
+		{name: "Split polygon by points", args: args{g: polygon, blade: points}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			gotGeometry, err := G.Split(tt.args.g, tt.args.blade)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GEOAlgorithm.Split() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(gotGeometry) != len(tt.want.(space.Collection)) || !gotGeometry.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("GEOAlgorithm.Split() = %v, want %v", wkt.MarshalString(gotGeometry), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
 func TestAlgorithm_LineMerge(t *testing.T) {
 	multiLineString0, _ := wkt.UnmarshalString(`MULTILINESTRING((-29 -27,-30 -29.7,-36 -31,-45 -33),(-45 -33,-46 -32))`)
 	expectLine0, _ := wkt.UnmarshalString(`MULTILINESTRING((-29 -27,-30 -29.7,-36 -31,-45 -33,-46 -32))`)
@@ -0,0 +1,94 @@
+package planar
+
+import (
+	"github.com/spatial-go/geoos/algorithm/prepared"
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+// PreparedGeometry is the geometry prepared for evaluating the predicates against many other geometries,
+// its segments and areas are indexed once, so the predicates don't build the graph of geometries.
+// The predicates of geometry not polygonal which can't be prepared fall back to the relate of geometries.
+type PreparedGeometry struct {
+	geom     space.Geometry
+	prepared *prepared.PreparedGeometry
+	g        *megrezAlgorithm
+}
+
+// Prepare returns the prepared geometry of geom.
+func (g *megrezAlgorithm) Prepare(geom space.Geometry) (*PreparedGeometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return &PreparedGeometry{
+		geom:     geom,
+		prepared: prepared.NewPreparedGeometry(geom.ToMatrix()),
+		g:        g,
+	}, nil
+}
+
+// Geometry returns the geometry prepared.
+func (p *PreparedGeometry) Geometry() space.Geometry {
+	return p.geom
+}
+
+// Contains returns TRUE if the prepared geometry contains geometry.
+func (p *PreparedGeometry) Contains(geom space.Geometry) (bool, error) {
+	if geom == nil || geom.IsEmpty() {
+		return false, spaceerr.ErrNilGeometry
+	}
+	if p.prepared.IsPolygonal() {
+		return p.prepared.Contains(geom.ToMatrix()), nil
+	}
+	return p.g.Contains(p.geom, geom)
+}
+
+// ContainsProperly returns TRUE if geometry is in the interior of the prepared geometry,
+// that is, geometry doesn't touch the boundary of the prepared one.
+func (p *PreparedGeometry) ContainsProperly(geom space.Geometry) (bool, error) {
+	if geom == nil || geom.IsEmpty() {
+		return false, spaceerr.ErrNilGeometry
+	}
+	if p.prepared.IsPolygonal() {
+		return p.prepared.ContainsProperly(geom.ToMatrix()), nil
+	}
+	return p.g.ContainsProperly(p.geom, geom)
+}
+
+// Covers returns TRUE if no point of geometry is outside the prepared geometry.
+func (p *PreparedGeometry) Covers(geom space.Geometry) (bool, error) {
+	if geom == nil || geom.IsEmpty() {
+		return false, spaceerr.ErrNilGeometry
+	}
+	if p.prepared.IsPolygonal() {
+		return p.prepared.Covers(geom.ToMatrix()), nil
+	}
+	return p.g.Covers(p.geom, geom)
+}
+
+// Intersects returns TRUE if the prepared geometry and geometry share any portion of space.
+func (p *PreparedGeometry) Intersects(geom space.Geometry) (bool, error) {
+	if geom == nil || geom.IsEmpty() {
+		return false, spaceerr.ErrNilGeometry
+	}
+	return p.prepared.Intersects(geom.ToMatrix()), nil
+}
+
+// Within returns TRUE if the prepared geometry is completely inside geometry.
+func (p *PreparedGeometry) Within(geom space.Geometry) (bool, error) {
+	if geom == nil || geom.IsEmpty() {
+		return false, spaceerr.ErrNilGeometry
+	}
+	if m := geom.ToMatrix(); prepared.IsPolygonal(m) {
+		return p.prepared.Within(m), nil
+	}
+	return p.g.Within(p.geom, geom)
+}
+
+// DWithin returns TRUE if the prepared geometry and geometry are within the distance of each other.
+func (p *PreparedGeometry) DWithin(geom space.Geometry, distance float64) (bool, error) {
+	if geom == nil || geom.IsEmpty() {
+		return false, spaceerr.ErrNilGeometry
+	}
+	return p.prepared.DWithin(geom.ToMatrix(), distance), nil
+}
@@ -0,0 +1,90 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/geoencoding/wkt"
+	"github.com/spatial-go/geoos/space"
+)
+
+func TestAlgorithm_Prepare(t *testing.T) {
+	G := NormalStrategy()
+	fence, _ := wkt.UnmarshalString("POLYGON((0 0,10 0,10 10,0 10,0 0),(4 4,6 4,6 6,4 6,4 4))")
+	p, err := G.Prepare(fence)
+	if err != nil {
+		t.Fatalf("Prepare() error = %v", err)
+	}
+	if !p.Geometry().Equals(fence) {
+		t.Errorf("Geometry() = %v, want %v", p.Geometry(), fence)
+	}
+	tests := []struct {
+		name                                                   string
+		geom                                                   string
+		contains, containsProperly, covers, intersects, within bool
+	}{
+		{"point interior", "POINT(2 2)", true, true, true, true, false},
+		{"point boundary", "POINT(0 5)", false, false, true, true, false},
+		{"point in hole", "POINT(5 5)", false, false, false, false, false},
+		{"line interior", "LINESTRING(1 1,3 2)", true, true, true, true, false},
+		{"line crossing hole", "LINESTRING(1 1,9 9)", false, false, false, true, false},
+		{"line exterior", "LINESTRING(11 0,11 10)", false, false, false, false, false},
+		{"polygon inside", "POLYGON((1 1,2 1,2 2,1 1))", true, true, true, true, false},
+		{"polygon containing", "POLYGON((-1 -1,11 -1,11 11,-1 11,-1 -1))", false, false, false, true, true},
+		{"polygon overlapping", "POLYGON((5 5,15 5,15 15,5 5))", false, false, false, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			geom, _ := wkt.UnmarshalString(tt.geom)
+			check := func(name string, predicate func(space.Geometry) (bool, error), want bool) {
+				got, err := predicate(geom)
+				if err != nil {
+					t.Errorf("%v() error = %v", name, err)
+					return
+				}
+				if got != want {
+					t.Errorf("%v() = %v, want %v", name, got, want)
+				}
+			}
+			check("Contains", p.Contains, tt.contains)
+			check("ContainsProperly", p.ContainsProperly, tt.containsProperly)
+			check("Covers", p.Covers, tt.covers)
+			check("Intersects", p.Intersects, tt.intersects)
+			check("Within", p.Within, tt.within)
+			if want, _ := G.Intersects(fence, geom); want != tt.intersects {
+				t.Errorf("Intersects() of algorithm = %v, want %v", want, tt.intersects)
+			}
+		})
+	}
+	if got, _ := p.DWithin(space.Point{5, 5}, 1); !got {
+		t.Errorf("DWithin() = false, want true")
+	}
+	if got, _ := p.DWithin(space.Point{5, 5}, 0.5); got {
+		t.Errorf("DWithin() = true, want false")
+	}
+	if _, err := p.Contains(nil); err == nil {
+		t.Errorf("Contains() of nil error = nil, want error")
+	}
+	if _, err := G.Prepare(nil); err == nil {
+		t.Errorf("Prepare() of nil error = nil, want error")
+	}
+}
+
+func TestAlgorithm_PrepareLine(t *testing.T) {
+	G := NormalStrategy()
+	line, _ := wkt.UnmarshalString("LINESTRING(0 0,10 10)")
+	p, err := G.Prepare(line)
+	if err != nil {
+		t.Fatalf("Prepare() error = %v", err)
+	}
+	point := space.Point{3, 3}
+	for name, predicate := range map[string]func(space.Geometry) (bool, error){
+		"Contains": p.Contains, "Covers": p.Covers, "Intersects": p.Intersects,
+	} {
+		if got, err := predicate(point); err != nil || !got {
+			t.Errorf("%v() = %v, %v, want true", name, got, err)
+		}
+	}
+	if got, _ := p.ContainsProperly(space.Point{0, 0}); got {
+		t.Errorf("ContainsProperly() of endpoint = true, want false")
+	}
+}
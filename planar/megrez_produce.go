@@ -2,9 +2,14 @@ package planar
 
 import (
 	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/densify"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/algorithm/overlay/snap"
 	"github.com/spatial-go/geoos/algorithm/simplify"
+	"github.com/spatial-go/geoos/algorithm/subdivision"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 // Boundary returns the closure of the combinatorial boundary of this space.Geometry.
@@ -24,6 +29,65 @@ func (g *megrezAlgorithm) BufferInMeter(geom space.Geometry, width float64, quad
 	return geom.BufferInMeter(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance.
+// The parameters specify the end cap style (round, flat or square), the join style (round, mitre or bevel),
+// the mitre limit and whether the buffer of line is single sided,
+// a positive width indicates the left side and a negative width the right side for a single sided buffer.
+func (g *megrezAlgorithm) BufferWithParams(geom space.Geometry, width float64, params *buffer.CurveParameters) (geometry space.Geometry) {
+	return geom.BufferWithParams(width, params)
+}
+
+// VariableBuffer Returns the buffer of a LineString with the width
+// interpolated between the start distance and the end distance along the line.
+func (g *megrezAlgorithm) VariableBuffer(geom space.Geometry, startDistance, endDistance float64, quadsegs int) (space.Geometry, error) {
+	ls, err := lineString(geom)
+	if err != nil {
+		return nil, err
+	}
+	return ls.VariableBuffer(startDistance, endDistance, quadsegs)
+}
+
+// VariableBufferDistances Returns the buffer of a LineString with the width given at every point.
+func (g *megrezAlgorithm) VariableBufferDistances(geom space.Geometry, distances []float64, quadsegs int) (space.Geometry, error) {
+	ls, err := lineString(geom)
+	if err != nil {
+		return nil, err
+	}
+	return ls.VariableBufferDistances(distances, quadsegs)
+}
+
+// VariableBufferInMeter Returns the buffer of a LineString with the width in meter
+// interpolated between the start distance and the end distance along the line.
+func (g *megrezAlgorithm) VariableBufferInMeter(geom space.Geometry, startDistance, endDistance float64, quadsegs int) (space.Geometry, error) {
+	ls, err := lineString(geom)
+	if err != nil {
+		return nil, err
+	}
+	return ls.VariableBufferInMeter(startDistance, endDistance, quadsegs)
+}
+
+// VariableBufferDistancesInMeter Returns the buffer of a LineString with the width in meter given at every point.
+func (g *megrezAlgorithm) VariableBufferDistancesInMeter(geom space.Geometry, distances []float64, quadsegs int) (space.Geometry, error) {
+	ls, err := lineString(geom)
+	if err != nil {
+		return nil, err
+	}
+	return ls.VariableBufferDistancesInMeter(distances, quadsegs)
+}
+
+// lineString returns the LineString of geometry, returns error if geometry is not a LineString.
+func lineString(geom space.Geometry) (space.LineString, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	ls, ok := geom.Geom().(space.LineString)
+	if !ok {
+		return nil, spaceerr.ErrNotLineString
+	}
+	return ls, nil
+}
+
 // Centroid  computes the geometric center of a geometry, or equivalently, the center of mass of the geometry as a POINT.
 // For [MULTI]POINTs, this is computed as the arithmetic mean of the input coordinates.
 // For [MULTI]LINESTRINGs, this is computed as the weighted length of each line segment.
@@ -49,6 +113,123 @@ func (g *megrezAlgorithm) ConvexHull(geom space.Geometry) (space.Geometry, error
 	return space.TransGeometry(result), nil
 }
 
+// ConcaveHull computes the concave hull of the points of geometry,
+// the border triangles of Delaunay triangulation are removed while their border edges are longer than maxEdgeLength.
+// The hull is a polygon, the holes are allowed if holesAllowed is true.
+// The convex hull is returned if the points are less than three or collinear.
+func (g *megrezAlgorithm) ConcaveHull(geom space.Geometry, maxEdgeLength float64, holesAllowed bool) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return space.TransGeometry(subdivision.ConcaveHull(geom.ToMatrix(), maxEdgeLength, holesAllowed)), nil
+}
+
+// ConcaveHullByLengthRatio computes the concave hull of the points of geometry,
+// the maximum edge length is the lengthRatio between the shortest and the longest edge of Delaunay triangulation,
+// the ratio 1 gives the convex hull and the ratio 0 gives the most concave hull.
+func (g *megrezAlgorithm) ConcaveHullByLengthRatio(geom space.Geometry, lengthRatio float64, holesAllowed bool) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return space.TransGeometry(subdivision.ConcaveHullByLengthRatio(geom.ToMatrix(), lengthRatio, holesAllowed)), nil
+}
+
+// AlphaShape computes the alpha shape of the points of geometry,
+// it is the union of the triangles of Delaunay triangulation whose circumradius is not larger than alpha.
+// The result is a polygon or a multi polygon whose parts may touch at the points,
+// the holes are removed if holesAllowed is false.
+func (g *megrezAlgorithm) AlphaShape(geom space.Geometry, alpha float64, holesAllowed bool) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return space.TransGeometry(subdivision.AlphaShape(geom.ToMatrix(), alpha, holesAllowed)), nil
+}
+
+// MinimumBoundingCircle returns the smallest circle which contains all the points of geometry,
+// returns error if the geometry is empty or its points are identical.
+func (g *megrezAlgorithm) MinimumBoundingCircle(geom space.Geometry) (*space.Circle, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	centre, radius := buffer.MinimumBoundingCircle(geom.ToMatrix())
+	if radius <= 0 {
+		return nil, spaceerr.ErrWrongRadius
+	}
+	return space.CreateCircle(space.Point(centre), radius)
+}
+
+// MinimumRotatedRectangle returns the rectangle of minimum area which contains all the points of geometry,
+// the rectangle may be rotated. The LineString is returned if the points are collinear
+// and the Point is returned if the points are identical.
+func (g *megrezAlgorithm) MinimumRotatedRectangle(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return space.TransGeometry(buffer.MinimumRotatedRectangle(geom.ToMatrix())), nil
+}
+
+// MinimumWidth returns the minimum width of geometry, it is the smallest distance
+// between two parallel lines which contain all the points of geometry between them.
+func (g *megrezAlgorithm) MinimumWidth(geom space.Geometry) (float64, error) {
+	if geom == nil || geom.IsEmpty() {
+		return 0, spaceerr.ErrNilGeometry
+	}
+	width, _ := buffer.MinimumDiameter(geom.ToMatrix())
+	return width, nil
+}
+
+// MinimumDiameter returns the LineString of minimum width of geometry,
+// it is from a vertex of convex hull to the supporting line of its opposite edge.
+func (g *megrezAlgorithm) MinimumDiameter(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	_, line := buffer.MinimumDiameter(geom.ToMatrix())
+	return space.LineString(line), nil
+}
+
+// MaximumInscribedCircle returns the largest circle contained in the polygonal geometry,
+// its centre is the pole of inaccessibility and its radius is accurate within tolerance.
+func (g *megrezAlgorithm) MaximumInscribedCircle(geom space.Geometry, tolerance float64) (*space.Circle, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	centre, radius := buffer.MaximumInscribedCircle(geom.ToMatrix(), tolerance)
+	if centre == nil {
+		return nil, spaceerr.ErrNotPolygon
+	}
+	return space.CreateCircle(space.Point(centre), radius)
+}
+
+// PoleOfInaccessibility returns the point of polygonal geometry which is farthest from the boundary,
+// the distance is accurate within tolerance.
+// It is an alternative of PointOnSurface which is away from the edges of concave polygons.
+func (g *megrezAlgorithm) PoleOfInaccessibility(geom space.Geometry, tolerance float64) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	centre, _ := buffer.MaximumInscribedCircle(geom.ToMatrix(), tolerance)
+	if centre == nil {
+		return nil, spaceerr.ErrNotPolygon
+	}
+	return space.Point(centre), nil
+}
+
+// LargestEmptyCircle returns the largest circle whose interior does not intersect the geometry,
+// the centre of circle is in the boundary polygon, it is the convex hull of geometry if the boundary is nil.
+// The radius is accurate within tolerance.
+func (g *megrezAlgorithm) LargestEmptyCircle(geom, boundary space.Geometry, tolerance float64) (*space.Circle, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	var bound matrix.Steric
+	if boundary != nil {
+		bound = boundary.ToMatrix()
+	}
+	centre, radius := buffer.LargestEmptyCircle(geom.ToMatrix(), bound, tolerance)
+	return space.CreateCircle(space.Point(centre), radius)
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -61,6 +242,73 @@ func (g *megrezAlgorithm) Envelope(geom space.Geometry) (space.Geometry, error)
 	}
 }
 
+// MakeValid returns a valid geometry which covers the same point set as the invalid geometry.
+// The polygons are repaired by operation.MakeValidLinework or operation.MakeValidStructure method,
+// the overlapping parts of multi polygon are merged.
+// The repeated points of lines are removed, the lines collapsed to a point become points.
+// The elements of collection are repaired one by one.
+func (g *megrezAlgorithm) MakeValid(geom space.Geometry, method int) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	switch geo := geom.Geom().(type) {
+	case space.Polygon:
+		return space.TransGeometry(operation.MakeValidPolygon(geo.ToMatrix(), method)), nil
+	case space.MultiPolygon:
+		switch result := space.TransGeometry(operation.MakeValidPolygon(geo.ToMatrix(), method)).(type) {
+		case space.Polygon:
+			if result.IsEmpty() {
+				return space.MultiPolygon{}, nil
+			}
+			return space.MultiPolygon{result}, nil
+		default:
+			return result, nil
+		}
+	case space.LineString:
+		return makeValidLine(geo), nil
+	case space.MultiLineString:
+		coll := space.Collection{}
+		lines := space.MultiLineString{}
+		for _, v := range geo {
+			line := makeValidLine(v)
+			if ls, ok := line.(space.LineString); ok {
+				lines = append(lines, ls)
+			}
+			coll = append(coll, line)
+		}
+		if len(lines) == len(coll) {
+			return lines, nil
+		}
+		return coll, nil
+	case space.Collection:
+		coll := space.Collection{}
+		for _, v := range geo {
+			elem, err := g.MakeValid(v, method)
+			if err != nil {
+				return nil, err
+			}
+			coll = append(coll, elem)
+		}
+		return coll, nil
+	default:
+		return geom, nil
+	}
+}
+
+// makeValidLine removes the repeated points of line, returns a point if the line collapses.
+func makeValidLine(line space.LineString) space.Geometry {
+	result := space.LineString{}
+	for _, v := range line {
+		if len(result) == 0 || !space.Point(v).Equals(space.Point(result[len(result)-1])) {
+			result = append(result, v)
+		}
+	}
+	if len(result) == 1 {
+		return space.Point(result[0])
+	}
+	return result
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (g *megrezAlgorithm) PointOnSurface(geom space.Geometry) (space.Geometry, error) {
 	m := buffer.InteriorPoint(geom.ToMatrix())
@@ -82,6 +330,31 @@ func (g *megrezAlgorithm) SimplifyP(geom space.Geometry, tolerance float64) (spa
 	return space.TransGeometry(result), nil
 }
 
+// Densify returns the geometry with the points inserted into the segments longer than maxSegmentLength,
+// the points divide the segment into the equal parts. The length is in the units of the coordinates.
+func (g *megrezAlgorithm) Densify(geom space.Geometry, maxSegmentLength float64) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if maxSegmentLength <= 0 {
+		return nil, spaceerr.ErrWrongSegmentLength
+	}
+	return space.TransGeometry(densify.Densify(geom.ToMatrix(), maxSegmentLength)), nil
+}
+
+// SegmentizeInMeter returns the geometry of longitude and latitude with the great circle points inserted
+// into the segments longer than maxSegmentLength in meter, so the long edges keep their shape
+// when they are transformed to another projection.
+func (g *megrezAlgorithm) SegmentizeInMeter(geom space.Geometry, maxSegmentLength float64) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if maxSegmentLength <= 0 {
+		return nil, spaceerr.ErrWrongSegmentLength
+	}
+	return space.TransGeometry(densify.SegmentizeInMeter(geom.ToMatrix(), maxSegmentLength)), nil
+}
+
 // Snap the vertices and segments of a geometry to another space.Geometry's vertices.
 // A snap distance tolerance is used to control where snapping is performed.
 // The result geometry is the input geometry with the vertices snapped.
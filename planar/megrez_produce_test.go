@@ -1,13 +1,18 @@
 package planar
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
 	"github.com/spatial-go/geoos"
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/debugtools"
 	"github.com/spatial-go/geoos/geoencoding/wkt"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func TestAlgorithm_Boundary(t *testing.T) {
@@ -194,6 +199,183 @@ func TestAlgorithm_ConvexHull(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_ConcaveHull(t *testing.T) {
+	points, _ := wkt.UnmarshalString(`MULTIPOINT(0 0,1 0,0 1,10 0,11 0,10 1)`)
+	expectHull, _ := wkt.UnmarshalString(`POLYGON((0 0,1 0,10 0,11 0,10 1,0 1,0 0))`)
+	expectShape, _ := wkt.UnmarshalString(`MULTIPOLYGON(((0 0,1 0,0 1,0 0)),((10 0,11 0,10 1,10 0)))`)
+
+	G := NormalStrategy()
+	tests := []struct {
+		name    string
+		hull    func() (space.Geometry, error)
+		want    space.Geometry
+		wantErr bool
+	}{
+		{name: "ConcaveHull", hull: func() (space.Geometry, error) { return G.ConcaveHull(points, 2, false) }, want: expectHull},
+		{name: "ConcaveHullByLengthRatio", hull: func() (space.Geometry, error) { return G.ConcaveHullByLengthRatio(points, 0, false) }, want: expectHull},
+		{name: "AlphaShape", hull: func() (space.Geometry, error) { return G.AlphaShape(points, 1, false) }, want: expectShape},
+		{name: "AlphaShape nil", hull: func() (space.Geometry, error) { return G.AlphaShape(nil, 1, false) }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotGeometry, err := tt.hull()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GEOAlgorithm.ConcaveHull() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			isEqual, _ := G.EqualsExact(gotGeometry, tt.want, 0.000001)
+			if !isEqual {
+				t.Errorf("GEOAlgorithm.ConcaveHull() = %v, want %v", wkt.MarshalString(gotGeometry), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_MinimumBoundingCircle(t *testing.T) {
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,6 0,3 4,3 1,0 0))`)
+	point, _ := wkt.UnmarshalString(`POINT(1 1)`)
+	tests := []struct {
+		name       string
+		g          space.Geometry
+		wantCentre space.Point
+		wantRadius float64
+		wantErr    bool
+	}{
+		{name: "circle polygon", g: polygon, wantCentre: space.Point{3, 0.875}, wantRadius: 3.125},
+		{name: "circle point", g: point, wantErr: true},
+		{name: "circle nil", g: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.MinimumBoundingCircle(tt.g)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GEOAlgorithm.MinimumBoundingCircle() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Centre.EqualsExact(tt.wantCentre, 0.000001) || math.Abs(got.Radius-tt.wantRadius) > 0.000001 {
+				t.Errorf("GEOAlgorithm.MinimumBoundingCircle() = %v %v, want %v %v", got.Centre, got.Radius, tt.wantCentre, tt.wantRadius)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_MinimumRotatedRectangle(t *testing.T) {
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,2 2,1 3,-1 1,0 0))`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(0 0,1 1,2 2)`)
+	expectPolygon, _ := wkt.UnmarshalString(`POLYGON((2 2,1 3,-1 1,0 0,2 2))`)
+	expectLine, _ := wkt.UnmarshalString(`LINESTRING(0 0,2 2)`)
+	tests := []struct {
+		name string
+		g    space.Geometry
+		want space.Geometry
+	}{
+		{name: "rectangle polygon", g: polygon, want: expectPolygon},
+		{name: "rectangle line", g: line, want: expectLine},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.MinimumRotatedRectangle(tt.g)
+			if err != nil {
+				t.Errorf("GEOAlgorithm.MinimumRotatedRectangle() error = %v", err)
+				return
+			}
+			if !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("GEOAlgorithm.MinimumRotatedRectangle() = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_MinimumDiameter(t *testing.T) {
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,4 0,4 1,0 1,0 0))`)
+	expectLine, _ := wkt.UnmarshalString(`LINESTRING(0 0,0 1)`)
+
+	G := NormalStrategy()
+	width, err := G.MinimumWidth(polygon)
+	if err != nil || width != 1 {
+		t.Errorf("GEOAlgorithm.MinimumWidth() = %v, %v, want %v", width, err, 1)
+	}
+	got, err := G.MinimumDiameter(polygon)
+	if err != nil || !got.EqualsExact(expectLine, 0.000001) {
+		t.Errorf("GEOAlgorithm.MinimumDiameter() = %v, %v, want %v", wkt.MarshalString(got), err, wkt.MarshalString(expectLine))
+	}
+	if _, err := G.MinimumWidth(nil); err == nil {
+		t.Errorf("GEOAlgorithm.MinimumWidth() error = %v, wantErr %v", err, true)
+	}
+}
+
+func TestAlgorithm_MaximumInscribedCircle(t *testing.T) {
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(0 0,1 1)`)
+	tests := []struct {
+		name       string
+		g          space.Geometry
+		wantCentre space.Point
+		wantRadius float64
+		wantErr    bool
+	}{
+		{name: "inscribed polygon", g: polygon, wantCentre: space.Point{5, 5}, wantRadius: 5},
+		{name: "inscribed line", g: line, wantErr: true},
+		{name: "inscribed nil", g: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.MaximumInscribedCircle(tt.g, 0.001)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GEOAlgorithm.MaximumInscribedCircle() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Centre.EqualsExact(tt.wantCentre, 0.001) || math.Abs(got.Radius-tt.wantRadius) > 0.001 {
+				t.Errorf("GEOAlgorithm.MaximumInscribedCircle() = %v %v, want %v %v", got.Centre, got.Radius, tt.wantCentre, tt.wantRadius)
+			}
+			pole, _ := G.PoleOfInaccessibility(tt.g, 0.001)
+			if !pole.EqualsExact(got.Centre, 0) {
+				t.Errorf("GEOAlgorithm.PoleOfInaccessibility() = %v, want %v", pole, got.Centre)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_LargestEmptyCircle(t *testing.T) {
+	points, _ := wkt.UnmarshalString(`MULTIPOINT(0 0,10 0,10 10,0 10)`)
+	boundary, _ := wkt.UnmarshalString(`POLYGON((0 0,4 0,4 4,0 4,0 0))`)
+	tests := []struct {
+		name       string
+		g          space.Geometry
+		boundary   space.Geometry
+		wantCentre space.Point
+		wantRadius float64
+	}{
+		{name: "empty circle", g: points, wantCentre: space.Point{5, 5}, wantRadius: math.Sqrt(50)},
+		{name: "empty circle boundary", g: points, boundary: boundary, wantCentre: space.Point{4, 4}, wantRadius: math.Sqrt(32)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.LargestEmptyCircle(tt.g, tt.boundary, 0.001)
+			if err != nil {
+				t.Errorf("GEOAlgorithm.LargestEmptyCircle() error = %v", err)
+				return
+			}
+			if !got.Centre.EqualsExact(tt.wantCentre, 0.01) || math.Abs(got.Radius-tt.wantRadius) > 0.001 {
+				t.Errorf("GEOAlgorithm.LargestEmptyCircle() = %v %v, want %v %v", got.Centre, got.Radius, tt.wantCentre, tt.wantRadius)
+			}
+		})
+	}
+}
+
 func TestAlgorithm_Envelope(t *testing.T) {
 	point, _ := wkt.UnmarshalString(`POINT(1 3)`)
 	expectPoint, _ := wkt.UnmarshalString(`POINT(1 3)`)
@@ -312,6 +494,58 @@ func TestAlgorithm_Simplify(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_Densify(t *testing.T) {
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,2 0,2 2,0 0))`)
+	expectPolygon, _ := wkt.UnmarshalString(`POLYGON((0 0,1 0,2 0,2 1,2 2,1 1,0 0))`)
+	multiLine, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,0 3),(1 1,1 2))`)
+	expectMultiLine, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,0 1.5,0 3),(1 1,1 2))`)
+
+	tests := []struct {
+		name             string
+		g                space.Geometry
+		maxSegmentLength float64
+		want             space.Geometry
+		wantErr          bool
+	}{
+		{name: "densify polygon", g: polygon, maxSegmentLength: 1.5, want: expectPolygon},
+		{name: "densify multi line", g: multiLine, maxSegmentLength: 2, want: expectMultiLine},
+		{name: "wrong length", g: polygon, maxSegmentLength: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.Densify(tt.g, tt.maxSegmentLength)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GEOAlgorithm.Densify() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("GEOAlgorithm.Densify() = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_SegmentizeInMeter(t *testing.T) {
+	line := space.LineString{{0, 0}, {0, 1}}
+	G := NormalStrategy()
+	got, err := G.SegmentizeInMeter(line, 50000)
+	if err != nil {
+		t.Fatalf("GEOAlgorithm.SegmentizeInMeter() error = %v", err)
+	}
+	// the meridian is a great circle, the points divide it into 3 equal parts.
+	want := space.LineString{{0, 0}, {0, 1.0 / 3}, {0, 2.0 / 3}, {0, 1}}
+	if !got.EqualsExact(want, 0.000001) {
+		t.Errorf("GEOAlgorithm.SegmentizeInMeter() = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(want))
+	}
+	if _, err := G.SegmentizeInMeter(space.LineString{}, 50000); err != spaceerr.ErrNilGeometry {
+		t.Errorf("GEOAlgorithm.SegmentizeInMeter() error = %v, want %v", err, spaceerr.ErrNilGeometry)
+	}
+}
+
 func TestAlgorithm_SimplifyP(t *testing.T) {
 	lineString, _ := wkt.UnmarshalString(`LINESTRING(0 0, 1 1, 0 2, 1 3, 0 4, 1 5)`)
 	expectLine, _ := wkt.UnmarshalString(`LINESTRING (0 0, 1 5)`)
@@ -412,6 +646,110 @@ func TestAlgorithm_UniquePoints(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_BufferWithParams(t *testing.T) {
+	line, _ := wkt.UnmarshalString("LINESTRING(0 0,10 0,10 10)")
+	roadCorridor, _ := wkt.UnmarshalString("POLYGON((9 1,9 10,11 10,11 -1,0 -1,0 1,9 1))")
+	setback, _ := wkt.UnmarshalString("POLYGON((0 0,10 0,10 10,11 10,11 -1,0 -1,0 0))")
+
+	type args struct {
+		geom   space.Geometry
+		width  float64
+		params *buffer.CurveParameters
+	}
+	tests := []struct {
+		name string
+		args args
+		want space.Geometry
+	}{
+		{name: "flat cap mitre join", args: args{geom: line, width: 1,
+			params: &buffer.CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CapFlat, JoinStyle: calc.JoinMitre,
+				MitreLimit: calc.MitreLimit, SimplifyFactor: calc.SimplifyFactor}}, want: roadCorridor},
+		{name: "single sided right", args: args{geom: line, width: -1,
+			params: &buffer.CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CapFlat, JoinStyle: calc.JoinMitre,
+				MitreLimit: calc.MitreLimit, SimplifyFactor: calc.SimplifyFactor, IsSingleSided: true}}, want: setback},
+		{name: "default params", args: args{geom: space.Point{100, 90}, width: 50},
+			want: space.Point{100, 90}.Buffer(50, calc.QuadrantSegments)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			gotGeometry := g.BufferWithParams(tt.args.geom, tt.args.width, tt.args.params)
+			isEqual, _ := g.EqualsExact(gotGeometry, tt.want, 0.000001)
+			if !isEqual {
+				t.Errorf("MegrezAlgorithm.BufferWithParams() = %v, want %v", wkt.MarshalString(gotGeometry), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_VariableBuffer(t *testing.T) {
+	line, _ := wkt.UnmarshalString("LINESTRING(0 0,10 0,20 0)")
+	want, _ := line.(space.LineString).VariableBufferDistances([]float64{1, 2, 3}, 8)
+	validLine, _ := space.CreateElementValid(line)
+	tests := []struct {
+		name    string
+		geom    space.Geometry
+		want    space.Geometry
+		wantErr error
+	}{
+		{name: "line", geom: line, want: want},
+		{name: "valid line", geom: validLine, want: want},
+		{name: "point", geom: space.Point{1, 1}, wantErr: spaceerr.ErrNotLineString},
+		{name: "nil", geom: nil, wantErr: spaceerr.ErrNilGeometry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			got, err := g.VariableBuffer(tt.geom, 1, 3, 8)
+			if err != tt.wantErr {
+				t.Fatalf("MegrezAlgorithm.VariableBuffer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if isEqual, _ := g.EqualsExact(got, tt.want, 0.000001); !isEqual {
+				t.Errorf("MegrezAlgorithm.VariableBuffer() = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_MakeValid(t *testing.T) {
+	bowtie, _ := wkt.UnmarshalString("POLYGON((0 0,2 2,2 0,0 2,0 0))")
+	bowtieValid, _ := wkt.UnmarshalString("MULTIPOLYGON(((0 0,1 1,0 2,0 0)),((1 1,2 0,2 2,1 1)))")
+	overlap, _ := wkt.UnmarshalString("MULTIPOLYGON(((0 0,2 0,2 2,0 2,0 0)),((1 1,3 1,3 3,1 3,1 1)))")
+	overlapValid, _ := wkt.UnmarshalString("MULTIPOLYGON(((0 0,2 0,2 1,3 1,3 3,1 3,1 2,0 2,0 0)))")
+	holeOutside, _ := wkt.UnmarshalString("POLYGON((0 0,4 0,4 4,0 4,0 0),(5 5,6 5,6 6,5 5))")
+	square, _ := wkt.UnmarshalString("POLYGON((0 0,4 0,4 4,0 4,0 0))")
+	line, _ := wkt.UnmarshalString("MULTILINESTRING((0 0,0 0,1 1),(2 2,2 2))")
+	lineValid := space.Collection{space.LineString{{0, 0}, {1, 1}}, space.Point{2, 2}}
+
+	tests := []struct {
+		name   string
+		geom   space.Geometry
+		method int
+		want   space.Geometry
+	}{
+		{name: "bow-tie", geom: bowtie, method: operation.MakeValidLinework, want: bowtieValid},
+		{name: "overlapping multipolygon", geom: overlap, method: operation.MakeValidLinework, want: overlapValid},
+		{name: "hole outside shell", geom: holeOutside, method: operation.MakeValidStructure, want: square},
+		{name: "collapsed line", geom: line, method: operation.MakeValidLinework, want: lineValid},
+		{name: "point", geom: space.Point{1, 1}, method: operation.MakeValidLinework, want: space.Point{1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			got, err := g.MakeValid(tt.geom, tt.method)
+			if err != nil {
+				t.Fatalf("MegrezAlgorithm.MakeValid() error = %v", err)
+			}
+			if isEqual, _ := g.EqualsExact(got, tt.want, 0.000001); !isEqual {
+				t.Errorf("MegrezAlgorithm.MakeValid() = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
 func TestAlgorithm_BufferInMeter(t *testing.T) {
 	wantGeometry, _ := wkt.UnmarshalString("POLYGON((110.00117265646337 40.00000000000001,110.00115012419823 39.99982474877957,110.00108339330515 39.999656231941024,110.00097502821495 39.99950092556494,110.00082919333724 39.999364798097254,110.00065149302459 39.99925308096896,110.00044875620038 39.99917006753527,110.00022877392705 39.99911894806501,110.00000000000001 39.99910168712361,109.99977122607295 39.99911894806501,109.99955124379962 39.99917006753527,109.9993485069754 39.99925308096896,109.99917080666276 39.999364798097254,109.99902497178505 39.99950092556494,109.99891660669483 39.999656231941024,109.99884987580177 39.99982474877957,109.99882734353663 40.00000000000001,109.99884987580177 40.00017525077067,109.99891660669483 40.00034376632828,109.99902497178505 40.00049907078736,109.99917080666276 40.000635195993794,109.9993485069754 40.00074691086084,109.99955124379962 40.00082992237753,109.99977122607295 40.00088104056688,110.00000000000001 40.00089830105848,110.00022877392705 40.00088104056688,110.00044875620038 40.00082992237753,110.00065149302459 40.00074691086084,110.00082919333724 40.000635195993794,110.00097502821495 40.00049907078736,110.00108339330515 40.00034376632828,110.00115012419823 40.00017525077067,110.00117265646337 40.00000000000001))")
 	// wantGeometry2, _ := wkt.UnmarshalString("POLYGON((110.09906815774535 40.10054562342642,110.09922522259059 40.10067419589715,110.09941206169721 40.10077685932706,110.09962149494307 40.10084966854274,110.09984547392598 40.10088982562589,110.1000753912593 40.10089578742074,110.10030241134876 40.100867324827774,110.10051780993972 40.10080553160651,110.10071330938504 40.10071278234935,110.10088139675076 40.10059264124038,110.10101561253364 40.10044972510394,110.10111079889639 40.10028952600239,110.1011632978805 40.10011820019832,110.10117109197948 40.09994233158786,110.1011338816704 40.09976867869421,110.10105309692463 40.09960391494264,110.10093184225462 40.09945437219815,110.00093184225464 39.99945357112295,110.00077477740938 39.99932480757869,110.00058793830277 39.999221991229994,110.00037850505693 39.99914907337598,110.00015452607398 39.99910885630793,109.9999246087407 39.99910288560356,109.9996975886512 39.999131390722596,109.99948219006026 39.999193276187356,109.99928669061492 39.999286163687735,109.99911860324923 39.999406483491484,109.99898438746635 39.999549611644895,109.99888920110361 39.999710047688836,109.99883670211949 39.999881626057096,109.99882890802051 40.00005775303085,109.99886611832957 40.00023166014029,109.99894690307536 40.00039666427477,109.99906815774537 40.000546424504286,110.09906815774535 40.10054562342642))")
@@ -1,6 +1,7 @@
 package planar
 
 import (
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
 )
 
@@ -14,6 +15,12 @@ func (g *megrezAlgorithm) Contains(A, B space.Geometry) (bool, error) {
 	return g.topog.Contains(A, B)
 }
 
+// ContainsProperly returns TRUE if geometry B is in the interior of geometry A,
+// that is, B doesn't touch the boundary of A, so a geometry doesn't contain itself properly.
+func (g *megrezAlgorithm) ContainsProperly(A, B space.Geometry) (bool, error) {
+	return g.topog.ContainsProperly(A, B)
+}
+
 // CoveredBy returns TRUE if no point in space.Geometry A is outside space.Geometry B
 func (g *megrezAlgorithm) CoveredBy(A, B space.Geometry) (bool, error) {
 	return g.topog.CoveredBy(A, B)
@@ -59,6 +66,19 @@ func (g *megrezAlgorithm) Relate(s, d space.Geometry) (string, error) {
 	return g.topog.Relate(s, d)
 }
 
+// RelateBoundaryNodeRule computes the intersection matrix for the spatial relationship between the two geometries,
+// the boundaries of lineal geometries are determined by the boundary node rule,
+// e.g. relate.EndPointBoundaryNodeRule for the network of lines.
+func (g *megrezAlgorithm) RelateBoundaryNodeRule(s, d space.Geometry, rule relate.BoundaryNodeRule) (string, error) {
+	return g.topog.RelateBoundaryNodeRule(s, d, rule)
+}
+
+// RelatePattern returns TRUE if the intersection matrix for the spatial relationship
+// between the two geometries matches the pattern, e.g. "T*F**F***".
+func (g *megrezAlgorithm) RelatePattern(s, d space.Geometry, pattern string) (bool, error) {
+	return g.topog.RelatePattern(s, d, pattern)
+}
+
 // Touches returns TRUE if the only points in common between A and B lie in the union of the boundaries of A and B.
 // The touches relation applies to all Area/Area, Line/Line, Line/Area, Point/Area and Point/Line pairs of relationships,
 // but not to the Point/Point pair.
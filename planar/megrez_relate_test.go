@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/spatial-go/geoos"
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/geoencoding/wkt"
 	"github.com/spatial-go/geoos/space"
 	"github.com/spatial-go/geoos/space/topograph"
@@ -532,3 +533,70 @@ func Test_megrezAlgorithm_Adjacency(t *testing.T) {
 		})
 	}
 }
+
+func TestAlgorithm_RelatePattern(t *testing.T) {
+	G := NormalStrategy()
+	square, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+	inner, _ := wkt.UnmarshalString(`POLYGON((1 1,2 1,2 2,1 1))`)
+	tests := []struct {
+		name    string
+		pattern string
+		want    bool
+		wantErr bool
+	}{
+		{"contains", "T*****FF*", true, false},
+		{"within", "T*F**F***", false, false},
+		{"wrong pattern", "T*", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := G.RelatePattern(square, inner, tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RelatePattern() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RelatePattern() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	line, _ := wkt.UnmarshalString(`LINESTRING(0 0,10 0)`)
+	point, _ := wkt.UnmarshalString(`POINT(5 0)`)
+	im, _ := G.RelateBoundaryNodeRule(line, point, relate.Mod2BoundaryNodeRule)
+	if got, _ := G.RelatePattern(line, point, im); !got || im != "0F1FF0FF2" {
+		t.Errorf("RelatePattern() of %v = %v, want true", im, got)
+	}
+	if got, _ := G.ContainsProperly(square, inner); !got {
+		t.Errorf("ContainsProperly() = false, want true")
+	}
+	if got, _ := G.ContainsProperly(square, square); got {
+		t.Errorf("ContainsProperly() of itself = true, want false")
+	}
+}
+
+func TestAlgorithm_RelateBoundaryNodeRule(t *testing.T) {
+	G := NormalStrategy()
+	// the loop road and the road starting from it.
+	loop, _ := wkt.UnmarshalString(`LINESTRING(0 0,1 0,1 1,0 0)`)
+	road, _ := wkt.UnmarshalString(`LINESTRING(0 0,-1 -1)`)
+	tests := []struct {
+		name string
+		rule relate.BoundaryNodeRule
+		want string
+	}{
+		{"Mod2", relate.Mod2BoundaryNodeRule, "F01FFF102"},
+		{"EndPoint", relate.EndPointBoundaryNodeRule, "FF1F0F102"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := G.RelateBoundaryNodeRule(loop, road, tt.rule)
+			if err != nil {
+				t.Errorf("RelateBoundaryNodeRule() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RelateBoundaryNodeRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -140,6 +140,42 @@ func TestAlgorithm_IsSimple(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_IsValidReason(t *testing.T) {
+	bowtie, _ := wkt.UnmarshalString(`POLYGON((0 0,2 2,2 0,0 2,0 0))`)
+	holeOutside, _ := wkt.UnmarshalString(`POLYGON((0 0,4 0,4 4,0 4,0 0),(5 5,6 5,6 6,5 5))`)
+	square, _ := wkt.UnmarshalString(`POLYGON((0 0,4 0,4 4,0 4,0 0))`)
+
+	tests := []struct {
+		name     string
+		g        space.Geometry
+		want     string
+		wantKind space.ValidErrorKind
+		wantErr  bool
+	}{
+		{name: "valid", g: square, want: space.ValidReasonValid},
+		{name: "bow-tie", g: bowtie, want: "Self-intersection[1 1]", wantKind: space.SelfIntersection},
+		{name: "hole outside shell", g: holeOutside, want: "Hole lies outside shell[5 5]", wantKind: space.HoleOutsideShell},
+		{name: "nil", g: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.IsValidReason(tt.g)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsValidReason() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsValidReason() got = %v, want %v", got, tt.want)
+			}
+			detail, _ := G.IsValidDetail(tt.g)
+			if (detail == nil && tt.wantKind != 0) || (detail != nil && detail.Kind != tt.wantKind) {
+				t.Errorf("IsValidDetail() got = %v, want %v", detail, tt.wantKind)
+			}
+		})
+	}
+}
+
 func TestAlgorithm_IsRing(t *testing.T) {
 	const linestring1 = `LINESTRING(1 2, 3 4, 5 6, 5 3, 1 2)`
 	const linestring2 = `LINESTRING(1 1,2 2,2 3.5,1 3,1 2,2 1)`
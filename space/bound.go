@@ -3,6 +3,7 @@ package space
 import (
 	"math"
 
+	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/filter"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/space/spaceerr"
@@ -267,6 +268,13 @@ func (b Bound) BufferInMeter(width float64, quadsegs int) Geometry {
 	return b.ToPolygon().BufferInMeter(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the parameters specify the end cap style, the join style, the mitre limit and whether the buffer is single sided.
+func (b Bound) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	return b.ToPolygon().BufferWithParams(width, params)
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -304,6 +312,16 @@ func (b Bound) IsValid() bool {
 	return b.Min.IsValid() && b.Max.IsValid()
 }
 
+// IsValidReason returns the reason why the geometry is invalid with the location, or "Valid Geometry".
+func (b Bound) IsValidReason() string {
+	return validReason(b)
+}
+
+// IsValidDetail returns the kind and location of the invalidity, returns nil if the geometry is valid.
+func (b Bound) IsValidDetail() *ValidError {
+	return validDetail(b)
+}
+
 // IsCorrect returns true if the geometry struct is Correct.
 func (b Bound) IsCorrect() bool {
 	return b.Min.IsCorrect() && b.Max.IsCorrect()
@@ -319,6 +337,22 @@ func (b Bound) Filter(f filter.Filter[matrix.Matrix]) Geometry {
 	return b
 }
 
+// Transform Returns this Bound transformed by the affine transformation,
+// it is a Bound if the transformation keeps the axes, e.g. translation and scaling, otherwise it is a Polygon.
+func (b Bound) Transform(t *matrix.AffineTransformation) Geometry {
+	if b.IsEmpty() {
+		return b
+	}
+	if m := t.Matrix(); m[0][1] != 0 || m[1][0] != 0 {
+		return b.ToPolygon().Transform(t)
+	}
+	p0, p1 := t.TransformMatrix(matrix.Matrix(b.Min)), t.TransformMatrix(matrix.Matrix(b.Max))
+	return Bound{
+		Min: Point{math.Min(p0[0], p1[0]), math.Min(p0[1], p1[1])},
+		Max: Point{math.Max(p0[0], p1[0]), math.Max(p0[1], p1[1])},
+	}
+}
+
 // Geom return Geometry without Coordinate System.
 func (b Bound) Geom() Geometry {
 	return b
@@ -243,6 +243,14 @@ func (c Collection) BufferInMeter(width float64, quadsegs int) Geometry {
 	return pg.bufferInMeter(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the parameters specify the end cap style, the join style, the mitre limit and whether the buffer is single sided.
+func (c Collection) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	pg := PlanarGeom[Collection]{c}
+	return pg.bufferWithParams(width, params)
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -287,6 +295,16 @@ func (c Collection) IsValid() bool {
 	return true
 }
 
+// IsValidReason returns the reason why the geometry is invalid with the location, or "Valid Geometry".
+func (c Collection) IsValidReason() string {
+	return validReason(c)
+}
+
+// IsValidDetail returns the kind and location of the invalidity, returns nil if the geometry is valid.
+func (c Collection) IsValidDetail() *ValidError {
+	return validDetail(c)
+}
+
 // IsCorrect returns true if the geometry struct is Correct.
 func (c Collection) IsCorrect() bool {
 	for _, v := range c {
@@ -313,6 +331,15 @@ func (c Collection) Filter(f filter.Filter[matrix.Matrix]) Geometry {
 	return mc
 }
 
+// Transform Returns this Collection with all its geometries transformed by the affine transformation.
+func (c Collection) Transform(t *matrix.AffineTransformation) Geometry {
+	result := make(Collection, 0, len(c))
+	for _, g := range c {
+		result = append(result, g.Transform(t))
+	}
+	return result
+}
+
 // Geom return Geometry without Coordinate System.
 func (c Collection) Geom() Geometry {
 	return c
@@ -1,6 +1,7 @@
 package space
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -26,3 +27,37 @@ func TestTransGeometry(t *testing.T) {
 		})
 	}
 }
+
+func TestGeometry_Transform(t *testing.T) {
+	translate := matrix.NewAffineTransformation().Translate(1, 2)
+	rotate := matrix.NewAffineTransformation().Rotate(math.Pi / 2)
+	tests := []struct {
+		name string
+		g    Geometry
+		t    *matrix.AffineTransformation
+		want Geometry
+	}{
+		{"point", Point{1, 1}, translate, Point{2, 3}},
+		{"multi point", MultiPoint{{0, 0}, {1, 1}}, translate, MultiPoint{{1, 2}, {2, 3}}},
+		{"line", LineString{{0, 0}, {1, 0}}, rotate, LineString{{0, 0}, {0, 1}}},
+		{"multi line", MultiLineString{{{0, 0}, {1, 0}}}, translate, MultiLineString{{{1, 2}, {2, 2}}}},
+		{"ring", Ring{{0, 0}, {1, 0}, {0, 1}, {0, 0}}, translate, Ring{{1, 2}, {2, 2}, {1, 3}, {1, 2}}},
+		{"polygon", Polygon{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}, rotate, Polygon{{{0, 0}, {0, 1}, {-1, 0}, {0, 0}}}},
+		{"multi polygon", MultiPolygon{{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}}, translate,
+			MultiPolygon{{{{1, 2}, {2, 2}, {1, 3}, {1, 2}}}}},
+		{"collection", Collection{Point{0, 0}, LineString{{0, 0}, {1, 1}}}, translate,
+			Collection{Point{1, 2}, LineString{{1, 2}, {2, 3}}}},
+		{"bound", Bound{Min: Point{0, 0}, Max: Point{1, 1}}, matrix.NewAffineTransformation().Scale(-2, 1),
+			Bound{Min: Point{-2, 0}, Max: Point{0, 1}}},
+		{"rotated bound", Bound{Min: Point{0, 0}, Max: Point{1, 1}}, rotate,
+			Polygon{{{0, 0}, {0, 1}, {-1, 1}, {-1, 0}, {0, 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.g.Transform(tt.t)
+			if got.GeoJSONType() != tt.want.GeoJSONType() || !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("Transform() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package space
 
 import (
+	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/filter"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
@@ -61,6 +62,11 @@ type Geometry interface {
 	// from this space.Geometry is less than or equal to distance.
 	BufferInMeter(width float64, quadsegs int) Geometry
 
+	// BufferWithParams Returns a geometry that represents all points whose distance
+	// from this space.Geometry is less than or equal to distance,
+	// the parameters specify the end cap style, the join style, the mitre limit and whether the buffer is single sided.
+	BufferWithParams(width float64, params *buffer.CurveParameters) Geometry
+
 	// Centroid Computes the centroid point of a geometry.
 	Centroid() Point
 
@@ -104,6 +110,13 @@ type Geometry interface {
 	// IsValid returns true if the  geometry is valid.
 	IsValid() bool
 
+	// IsValidReason returns the reason why the geometry is invalid with the location, or "Valid Geometry".
+	IsValidReason() string
+
+	// IsValidDetail returns the kind and location of the invalidity, returns nil if the geometry is valid.
+	// Unlike IsValid, it checks the topology of the geometry as well as its coordinates.
+	IsValidDetail() *ValidError
+
 	// IsCorrect returns true if the geometry struct is Correct.
 	IsCorrect() bool
 
@@ -129,6 +142,9 @@ type Geometry interface {
 
 	// Filter Performs an operation with the provided .
 	Filter(f filter.Filter[matrix.Matrix]) Geometry
+
+	// Transform Returns the geometry transformed by the affine transformation.
+	Transform(t *matrix.AffineTransformation) Geometry
 }
 
 // compile time checks
@@ -1,8 +1,11 @@
 package space
 
 import (
+	"math"
+
 	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/filter"
+	"github.com/spatial-go/geoos/algorithm/linearref"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
 	"github.com/spatial-go/geoos/algorithm/operation"
@@ -212,6 +215,229 @@ func (ls LineString) BufferInMeter(width float64, quadsegs int) Geometry {
 	return pg.bufferInMeter(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the parameters specify the end cap style, the join style, the mitre limit and whether the buffer is single sided.
+func (ls LineString) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	pg := PlanarGeom[LineString]{ls}
+	return pg.bufferWithParams(width, params)
+}
+
+// VariableBuffer Returns the buffer of this LineString with the width
+// interpolated between the start distance and the end distance along the line, the sign of distances is ignored.
+func (ls LineString) VariableBuffer(startDistance, endDistance float64, quadsegs int) (Geometry, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return variableBuffer(ls, ls.interpolateDistances(startDistance, endDistance), quadsegs), nil
+}
+
+// VariableBufferDistances Returns the buffer of this LineString with the width given at every point,
+// the number of distances should be equal to the number of points and the sign of distances is ignored.
+func (ls LineString) VariableBufferDistances(distances []float64, quadsegs int) (Geometry, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if len(distances) != len(ls) {
+		return nil, spaceerr.ErrWrongDistances
+	}
+	return variableBuffer(ls, absDistances(distances), quadsegs), nil
+}
+
+// VariableBufferInMeter Returns the buffer of this LineString with the width in meter
+// interpolated between the start distance and the end distance along the line, the sign of distances is ignored.
+func (ls LineString) VariableBufferInMeter(startDistance, endDistance float64, quadsegs int) (Geometry, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return variableBufferInMeter(ls, ls.interpolateDistances(startDistance, endDistance), quadsegs), nil
+}
+
+// VariableBufferDistancesInMeter Returns the buffer of this LineString with the width in meter given at every point,
+// the number of distances should be equal to the number of points and the sign of distances is ignored.
+func (ls LineString) VariableBufferDistancesInMeter(distances []float64, quadsegs int) (Geometry, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if len(distances) != len(ls) {
+		return nil, spaceerr.ErrWrongDistances
+	}
+	return variableBufferInMeter(ls, absDistances(distances), quadsegs), nil
+}
+
+// absDistances returns the absolute values of distances, the distances given are not changed.
+func absDistances(distances []float64) []float64 {
+	abs := make([]float64, len(distances))
+	for i, v := range distances {
+		abs[i] = math.Abs(v)
+	}
+	return abs
+}
+
+// interpolateDistances returns the distances at the points interpolated by the length along the line.
+func (ls LineString) interpolateDistances(startDistance, endDistance float64) []float64 {
+	startDistance, endDistance = math.Abs(startDistance), math.Abs(endDistance)
+	distances := make([]float64, len(ls))
+	totalLen := ls.Length()
+	currLen := 0.0
+	for i := range ls {
+		if i > 0 {
+			currLen += measure.PlanarDistance(matrix.Matrix(ls[i-1]), matrix.Matrix(ls[i]))
+		}
+		if totalLen == 0 {
+			distances[i] = startDistance
+			continue
+		}
+		distances[i] = startDistance + currLen/totalLen*(endDistance-startDistance)
+	}
+	return distances
+}
+
+// LineInterpolatePoint Returns the point at the fraction of the length along this LineString,
+// the fraction should be in the range [0, 1].
+func (ls LineString) LineInterpolatePoint(fraction float64) (Point, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if fraction < 0 || fraction > 1 {
+		return nil, spaceerr.ErrWrongFraction
+	}
+	return ls.ExtractPoint(fraction * ls.Length()), nil
+}
+
+// LineLocatePoint Returns the fraction of the length along this LineString
+// where the point on it nearest to the point is.
+func (ls LineString) LineLocatePoint(point Point) (float64, error) {
+	if ls.IsEmpty() || point.IsEmpty() {
+		return 0, spaceerr.ErrNilGeometry
+	}
+	length := ls.Length()
+	if length == 0 {
+		return 0, nil
+	}
+	return linearref.Project(ls.lineMatrixes(), point.ToMatrix().(matrix.Matrix)) / length, nil
+}
+
+// LineSubstring Returns the part of this LineString between the two fractions of its length,
+// the fractions should be in the range [0, 1] and from should not be larger than to.
+// The part is a Point if the fractions are equal.
+func (ls LineString) LineSubstring(from, to float64) (Geometry, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if from < 0 || to > 1 || from > to {
+		return nil, spaceerr.ErrWrongFraction
+	}
+	length := ls.Length()
+	if from == to {
+		return ls.ExtractPoint(from * length), nil
+	}
+	return ls.ExtractLine(from*length, to*length), nil
+}
+
+// ExtractPoint Returns the point at the length along this LineString,
+// the negative length is measured from the end and the length beyond the line is clamped to it.
+func (ls LineString) ExtractPoint(length float64) Point {
+	if ls.IsEmpty() {
+		return nil
+	}
+	return Point(linearref.ExtractPoint(ls.lineMatrixes(), length))
+}
+
+// ExtractLine Returns the part of this LineString between the two lengths along it,
+// the negative length is measured from the end and the length beyond the line is clamped to it.
+// The part is reversed if start is larger than end, it is empty if this LineString has only one point.
+func (ls LineString) ExtractLine(start, end float64) Geometry {
+	if ls.IsEmpty() {
+		return nil
+	}
+	parts := linearref.ExtractLine(ls.lineMatrixes(), start, end)
+	if len(parts) == 0 {
+		return LineString{}
+	}
+	return LineString(parts[0])
+}
+
+// IsMeasured returns true if all the points of this LineString carry the measure as the fourth ordinate {x, y, z, m}.
+func (ls LineString) IsMeasured() bool {
+	return linearref.IsMeasured(matrix.LineMatrix(ls))
+}
+
+// AddMeasure Returns this LineString with the measures interpolated by the length from start to end,
+// the existing measures are replaced and the z of points is kept, it is 0 for the 2D points.
+func (ls LineString) AddMeasure(start, end float64) (LineString, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return LineString(linearref.AddMeasure(matrix.LineMatrix(ls), start, end)), nil
+}
+
+// CalibrateMeasure Returns this LineString with the measures interpolated by the length between the calibration points,
+// each calibration point carries its measure as the fourth ordinate and is located at the nearest point of this LineString.
+// The measures before the first and after the last calibration point are extrapolated.
+func (ls LineString) CalibrateMeasure(points MultiPoint) (LineString, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	pts := make([]matrix.Matrix, 0, len(points))
+	for _, p := range points {
+		pts = append(pts, matrix.Matrix(p))
+	}
+	calibrated := linearref.CalibrateMeasure(matrix.LineMatrix(ls), pts)
+	if calibrated == nil {
+		return nil, spaceerr.ErrWrongCalibration
+	}
+	return LineString(calibrated), nil
+}
+
+// LocateAlong Returns the points of this measured LineString where the measure is m, as a MultiPoint.
+// The points are in the order along the line, it may be several points if the measures are not monotonic.
+func (ls LineString) LocateAlong(m float64) (Geometry, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if !ls.IsMeasured() {
+		return nil, spaceerr.ErrNotMeasured
+	}
+	result := MultiPoint{}
+	for _, p := range linearref.LocateAlong(matrix.LineMatrix(ls), m) {
+		result = append(result, Point(p))
+	}
+	return result, nil
+}
+
+// LocateBetween Returns the parts of this measured LineString where the measure is between from and to,
+// as a MultiLineString. The parts are in the order along the line.
+func (ls LineString) LocateBetween(from, to float64) (Geometry, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if !ls.IsMeasured() {
+		return nil, spaceerr.ErrNotMeasured
+	}
+	result := MultiLineString{}
+	for _, part := range linearref.LocateBetween(matrix.LineMatrix(ls), from, to) {
+		result = append(result, LineString(part))
+	}
+	return result, nil
+}
+
+// InterpolateMeasure Returns the measure at the point of this measured LineString nearest to the point.
+func (ls LineString) InterpolateMeasure(point Point) (float64, error) {
+	if ls.IsEmpty() || point.IsEmpty() {
+		return 0, spaceerr.ErrNilGeometry
+	}
+	if !ls.IsMeasured() {
+		return 0, spaceerr.ErrNotMeasured
+	}
+	return linearref.InterpolateMeasure(matrix.LineMatrix(ls), matrix.Matrix(point)), nil
+}
+
+// lineMatrixes returns this LineString as the lines of linear referencing.
+func (ls LineString) lineMatrixes() []matrix.LineMatrix {
+	return []matrix.LineMatrix{matrix.LineMatrix(ls)}
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -253,6 +479,16 @@ func (ls LineString) IsValid() bool {
 	return true
 }
 
+// IsValidReason returns the reason why the geometry is invalid with the location, or "Valid Geometry".
+func (ls LineString) IsValidReason() string {
+	return validReason(ls)
+}
+
+// IsValidDetail returns the kind and location of the invalidity, returns nil if the geometry is valid.
+func (ls LineString) IsValidDetail() *ValidError {
+	return validDetail(ls)
+}
+
 // IsCorrect returns true if the geometry struct is Correct.
 func (ls LineString) IsCorrect() bool {
 	if ls.IsEmpty() {
@@ -281,6 +517,11 @@ func (ls LineString) Filter(f filter.Filter[matrix.Matrix]) Geometry {
 	return ls
 }
 
+// Transform Returns this LineString transformed by the affine transformation.
+func (ls LineString) Transform(t *matrix.AffineTransformation) Geometry {
+	return LineString(t.Transform(matrix.LineMatrix(ls)).(matrix.LineMatrix))
+}
+
 // Geom return Geometry without Coordinate System.
 func (ls LineString) Geom() Geometry {
 	return ls
@@ -1,10 +1,14 @@
 package space
 
 import (
+	"math"
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/filter"
 	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func TestLineString_Filter(t *testing.T) {
@@ -27,3 +31,170 @@ func TestLineString_Filter(t *testing.T) {
 		})
 	}
 }
+
+func TestLineString_VariableBuffer(t *testing.T) {
+	line := LineString{{0, 0}, {10, 0}, {20, 0}}
+	lbuffer := &buffer.VariableLineBuffer{Line: matrix.LineMatrix(line), QuadrantSegments: 8}
+	want := TransGeometry(lbuffer.InterpolatedBuffer(1, 3))
+
+	got, err := line.VariableBuffer(1, 3, 8)
+	if err != nil {
+		t.Fatalf("VariableBuffer() error = %v", err)
+	}
+	if !got.EqualsExact(want, 0.000001) {
+		t.Errorf("VariableBuffer() = %v, want %v", got, want)
+	}
+
+	got, err = line.VariableBufferDistances([]float64{1, 2, 3}, 8)
+	if err != nil {
+		t.Fatalf("VariableBufferDistances() error = %v", err)
+	}
+	if !got.EqualsExact(want, 0.000001) {
+		t.Errorf("VariableBufferDistances() = %v, want %v", got, want)
+	}
+
+	// the sign of distances is ignored as the one of interpolated distances.
+	distances := []float64{-1, 2, -3}
+	got, err = line.VariableBufferDistances(distances, 8)
+	if err != nil {
+		t.Fatalf("VariableBufferDistances() error = %v", err)
+	}
+	if !got.EqualsExact(want, 0.000001) {
+		t.Errorf("VariableBufferDistances() of negative distances = %v, want %v", got, want)
+	}
+	if distances[0] != -1 || distances[2] != -3 {
+		t.Errorf("VariableBufferDistances() changed distances to %v", distances)
+	}
+	if got, _ := line.VariableBuffer(-1, -3, 8); !got.EqualsExact(want, 0.000001) {
+		t.Errorf("VariableBuffer() of negative distances = %v, want %v", got, want)
+	}
+
+	if _, err := line.VariableBufferDistances([]float64{1, 2}, 8); err != spaceerr.ErrWrongDistances {
+		t.Errorf("VariableBufferDistances() error = %v, want %v", err, spaceerr.ErrWrongDistances)
+	}
+}
+
+func TestLineString_VariableBufferInMeter(t *testing.T) {
+	line := LineString{{116.3, 39.9}, {116.31, 39.9}}
+	want := line.BufferInMeter(100, 8)
+
+	got, err := line.VariableBufferDistancesInMeter([]float64{100, 100}, 8)
+	if err != nil {
+		t.Fatalf("VariableBufferDistancesInMeter() error = %v", err)
+	}
+	if !got.EqualsExact(want, 0.000001) {
+		t.Errorf("VariableBufferDistancesInMeter() = %v, want %v", got, want)
+	}
+
+	got, err = line.VariableBufferInMeter(100, 200, 8)
+	if err != nil {
+		t.Fatalf("VariableBufferInMeter() error = %v", err)
+	}
+	// the buffer is as wide as the distance at the end point.
+	bound := got.Bound()
+	width := measure.SpheroidDistance(matrix.Matrix{116.31, bound.Min.Y()}, matrix.Matrix{116.31, bound.Max.Y()})
+	if math.Abs(width-400) > 4 {
+		t.Errorf("VariableBufferInMeter() width = %v, want 400", width)
+	}
+}
+
+func TestLineString_LinearReferencing(t *testing.T) {
+	line := LineString{{0, 0}, {10, 0}, {10, 10}}
+
+	point, err := line.LineInterpolatePoint(0.75)
+	if err != nil {
+		t.Fatalf("LineInterpolatePoint() error = %v", err)
+	}
+	if !point.Equals(Point{10, 5}) {
+		t.Errorf("LineInterpolatePoint() = %v, want %v", point, Point{10, 5})
+	}
+	if _, err := line.LineInterpolatePoint(1.5); err != spaceerr.ErrWrongFraction {
+		t.Errorf("LineInterpolatePoint() error = %v, want %v", err, spaceerr.ErrWrongFraction)
+	}
+
+	fraction, err := line.LineLocatePoint(Point{12, 5})
+	if err != nil {
+		t.Fatalf("LineLocatePoint() error = %v", err)
+	}
+	if fraction != 0.75 {
+		t.Errorf("LineLocatePoint() = %v, want %v", fraction, 0.75)
+	}
+
+	tests := []struct {
+		name     string
+		from, to float64
+		want     Geometry
+	}{
+		{"part", 0.25, 0.75, LineString{{5, 0}, {10, 0}, {10, 5}}},
+		{"point", 0.5, 0.5, Point{10, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := line.LineSubstring(tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("LineSubstring() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("LineSubstring() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := line.LineSubstring(0.75, 0.25); err != spaceerr.ErrWrongFraction {
+		t.Errorf("LineSubstring() error = %v, want %v", err, spaceerr.ErrWrongFraction)
+	}
+
+	if got := line.ExtractPoint(-5); !got.Equals(Point{10, 5}) {
+		t.Errorf("ExtractPoint() = %v, want %v", got, Point{10, 5})
+	}
+	if got, want := line.ExtractLine(15, 5), (LineString{{10, 5}, {10, 0}, {5, 0}}); !got.Equals(want) {
+		t.Errorf("ExtractLine() = %v, want %v", got, want)
+	}
+	if got, err := (LineString{{1, 1}}).LineSubstring(0, 1); err != nil || !got.IsEmpty() {
+		t.Errorf("LineSubstring() = %v, %v, want empty", got, err)
+	}
+}
+
+func TestLineString_Measure(t *testing.T) {
+	line := LineString{{0, 0}, {10, 0}, {10, 10}}
+	if _, err := line.LocateAlong(5); err != spaceerr.ErrNotMeasured {
+		t.Errorf("LocateAlong() error = %v, want %v", err, spaceerr.ErrNotMeasured)
+	}
+
+	route, err := line.CalibrateMeasure(MultiPoint{{0, 0, 0, 100}, {10, 10, 0, 120}})
+	if err != nil {
+		t.Fatalf("CalibrateMeasure() error = %v", err)
+	}
+	if want := (LineString{{0, 0, 0, 100}, {10, 0, 0, 110}, {10, 10, 0, 120}}); !route.Equals(want) {
+		t.Errorf("CalibrateMeasure() = %v, want %v", route, want)
+	}
+	if _, err := line.CalibrateMeasure(MultiPoint{{0, 0, 0, 100}}); err != spaceerr.ErrWrongCalibration {
+		t.Errorf("CalibrateMeasure() error = %v, want %v", err, spaceerr.ErrWrongCalibration)
+	}
+	if added, _ := line.AddMeasure(100, 120); !added.Equals(route) {
+		t.Errorf("AddMeasure() = %v, want %v", added, route)
+	}
+
+	points, err := route.LocateAlong(115)
+	if err != nil {
+		t.Fatalf("LocateAlong() error = %v", err)
+	}
+	if want := (MultiPoint{{10, 5, 0, 115}}); !points.Equals(want) {
+		t.Errorf("LocateAlong() = %v, want %v", points, want)
+	}
+
+	parts, err := route.LocateBetween(105, 115)
+	if err != nil {
+		t.Fatalf("LocateBetween() error = %v", err)
+	}
+	if want := (MultiLineString{{{5, 0, 0, 105}, {10, 0, 0, 110}, {10, 5, 0, 115}}}); !parts.Equals(want) {
+		t.Errorf("LocateBetween() = %v, want %v", parts, want)
+	}
+
+	m, err := route.InterpolateMeasure(Point{4, -3})
+	if err != nil {
+		t.Fatalf("InterpolateMeasure() error = %v", err)
+	}
+	if m != 104 || (Point{4, -3, 0, 104}).M() != 104 {
+		t.Errorf("InterpolateMeasure() = %v, want %v", m, 104)
+	}
+}
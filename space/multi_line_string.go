@@ -3,10 +3,12 @@ package space
 import (
 	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/filter"
+	"github.com/spatial-go/geoos/algorithm/linearref"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
 	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/algorithm/simplify"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 // MultiLineString is a set of polylines.
@@ -223,6 +225,88 @@ func (mls MultiLineString) BufferInMeter(width float64, quadsegs int) Geometry {
 	return pg.bufferInMeter(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the parameters specify the end cap style, the join style, the mitre limit and whether the buffer is single sided.
+func (mls MultiLineString) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	pg := PlanarGeom[MultiLineString]{mls}
+	return pg.bufferWithParams(width, params)
+}
+
+// LineInterpolatePoint Returns the point at the fraction of the total length along the lines of this MultiLineString,
+// the lines are measured one after another and the fraction should be in the range [0, 1].
+func (mls MultiLineString) LineInterpolatePoint(fraction float64) (Point, error) {
+	if mls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if fraction < 0 || fraction > 1 {
+		return nil, spaceerr.ErrWrongFraction
+	}
+	return mls.ExtractPoint(fraction * mls.Length()), nil
+}
+
+// LineLocatePoint Returns the fraction of the total length along the lines of this MultiLineString
+// where the point on them nearest to the point is.
+func (mls MultiLineString) LineLocatePoint(point Point) (float64, error) {
+	if mls.IsEmpty() || point.IsEmpty() {
+		return 0, spaceerr.ErrNilGeometry
+	}
+	length := mls.Length()
+	if length == 0 {
+		return 0, nil
+	}
+	return linearref.Project(mls.lineMatrixes(), point.ToMatrix().(matrix.Matrix)) / length, nil
+}
+
+// LineSubstring Returns the parts of the lines of this MultiLineString between the two fractions of the total length,
+// the fractions should be in the range [0, 1] and from should not be larger than to.
+// The part is a Point if the fractions are equal.
+func (mls MultiLineString) LineSubstring(from, to float64) (Geometry, error) {
+	if mls.IsEmpty() {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if from < 0 || to > 1 || from > to {
+		return nil, spaceerr.ErrWrongFraction
+	}
+	length := mls.Length()
+	if from == to {
+		return mls.ExtractPoint(from * length), nil
+	}
+	return mls.ExtractLine(from*length, to*length), nil
+}
+
+// ExtractPoint Returns the point at the length along the lines of this MultiLineString,
+// the negative length is measured from the end and the length beyond the lines is clamped to them.
+func (mls MultiLineString) ExtractPoint(length float64) Point {
+	if mls.IsEmpty() {
+		return nil
+	}
+	return Point(linearref.ExtractPoint(mls.lineMatrixes(), length))
+}
+
+// ExtractLine Returns the parts of the lines of this MultiLineString between the two lengths along them,
+// the negative length is measured from the end and the length beyond the lines is clamped to them.
+// The parts are reversed if start is larger than end.
+func (mls MultiLineString) ExtractLine(start, end float64) Geometry {
+	if mls.IsEmpty() {
+		return nil
+	}
+	result := MultiLineString{}
+	for _, part := range linearref.ExtractLine(mls.lineMatrixes(), start, end) {
+		result = append(result, LineString(part))
+	}
+	return result
+}
+
+// lineMatrixes returns the lines of this MultiLineString for linear referencing.
+func (mls MultiLineString) lineMatrixes() []matrix.LineMatrix {
+	lines := make([]matrix.LineMatrix, 0, len(mls))
+	for _, ls := range mls {
+		lines = append(lines, matrix.LineMatrix(ls))
+	}
+	return lines
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -261,6 +345,16 @@ func (mls MultiLineString) IsValid() bool {
 	return true
 }
 
+// IsValidReason returns the reason why the geometry is invalid with the location, or "Valid Geometry".
+func (mls MultiLineString) IsValidReason() string {
+	return validReason(mls)
+}
+
+// IsValidDetail returns the kind and location of the invalidity, returns nil if the geometry is valid.
+func (mls MultiLineString) IsValidDetail() *ValidError {
+	return validDetail(mls)
+}
+
 // IsCorrect returns true if the geometry struct is Correct.
 func (mls MultiLineString) IsCorrect() bool {
 	for _, v := range mls {
@@ -288,6 +382,15 @@ func (mls MultiLineString) Filter(f filter.Filter[matrix.Matrix]) Geometry {
 	return ml
 }
 
+// Transform Returns this MultiLineString transformed by the affine transformation.
+func (mls MultiLineString) Transform(t *matrix.AffineTransformation) Geometry {
+	result := make(MultiLineString, 0, len(mls))
+	for _, ls := range mls {
+		result = append(result, ls.Transform(t).(LineString))
+	}
+	return result
+}
+
 // Geom return Geometry without Coordinate System.
 func (mls MultiLineString) Geom() Geometry {
 	return mls
@@ -48,3 +48,37 @@ func TestMultiLineString_Filter(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiLineString_LinearReferencing(t *testing.T) {
+	mls := MultiLineString{{{0, 0}, {10, 0}}, {{20, 0}, {20, 10}}}
+
+	point, err := mls.LineInterpolatePoint(0.6)
+	if err != nil {
+		t.Fatalf("LineInterpolatePoint() error = %v", err)
+	}
+	if !point.Equals(Point{20, 2}) {
+		t.Errorf("LineInterpolatePoint() = %v, want %v", point, Point{20, 2})
+	}
+
+	fraction, err := mls.LineLocatePoint(Point{21, 4})
+	if err != nil {
+		t.Fatalf("LineLocatePoint() error = %v", err)
+	}
+	if fraction != 0.7 {
+		t.Errorf("LineLocatePoint() = %v, want %v", fraction, 0.7)
+	}
+
+	got, err := mls.LineSubstring(0.25, 0.75)
+	if err != nil {
+		t.Fatalf("LineSubstring() error = %v", err)
+	}
+	want := MultiLineString{{{5, 0}, {10, 0}}, {{20, 0}, {20, 5}}}
+	if !got.Equals(want) {
+		t.Errorf("LineSubstring() = %v, want %v", got, want)
+	}
+
+	if got, want := (MultiLineString{{{0, 0}, {10, 0}}, {{20, 0}, {30, 0}}}).ExtractLine(10, 12),
+		(MultiLineString{{{20, 0}, {22, 0}}}); !got.Equals(want) {
+		t.Errorf("ExtractLine() = %v, want %v", got, want)
+	}
+}
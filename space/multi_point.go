@@ -180,6 +180,14 @@ func (mp MultiPoint) BufferInMeter(width float64, quadsegs int) Geometry {
 	return pg.bufferInMeter(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the parameters specify the end cap style, the join style, the mitre limit and whether the buffer is single sided.
+func (mp MultiPoint) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	pg := PlanarGeom[MultiPoint]{mp}
+	return pg.bufferWithParams(width, params)
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -224,6 +232,16 @@ func (mp MultiPoint) IsValid() bool {
 	return true
 }
 
+// IsValidReason returns the reason why the geometry is invalid with the location, or "Valid Geometry".
+func (mp MultiPoint) IsValidReason() string {
+	return validReason(mp)
+}
+
+// IsValidDetail returns the kind and location of the invalidity, returns nil if the geometry is valid.
+func (mp MultiPoint) IsValidDetail() *ValidError {
+	return validDetail(mp)
+}
+
 // IsCorrect returns true if the geometry struct is Correct.
 func (mp MultiPoint) IsCorrect() bool {
 	for _, v := range mp {
@@ -249,6 +267,15 @@ func (mp MultiPoint) Filter(f filter.Filter[matrix.Matrix]) Geometry {
 	return mp
 }
 
+// Transform Returns this MultiPoint transformed by the affine transformation.
+func (mp MultiPoint) Transform(t *matrix.AffineTransformation) Geometry {
+	result := make(MultiPoint, 0, len(mp))
+	for _, p := range mp {
+		result = append(result, p.Transform(t).(Point))
+	}
+	return result
+}
+
 // Geom return Geometry without Coordinate System.
 func (mp MultiPoint) Geom() Geometry {
 	return mp
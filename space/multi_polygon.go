@@ -199,6 +199,14 @@ func (mp MultiPolygon) BufferInMeter(width float64, quadsegs int) Geometry {
 	return pg.bufferInMeter(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the parameters specify the end cap style, the join style, the mitre limit and whether the buffer is single sided.
+func (mp MultiPolygon) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	pg := PlanarGeom[MultiPolygon]{mp}
+	return pg.bufferWithParams(width, params)
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -243,6 +251,16 @@ func (mp MultiPolygon) IsValid() bool {
 	return true
 }
 
+// IsValidReason returns the reason why the geometry is invalid with the location, or "Valid Geometry".
+func (mp MultiPolygon) IsValidReason() string {
+	return validReason(mp)
+}
+
+// IsValidDetail returns the kind and location of the invalidity, returns nil if the geometry is valid.
+func (mp MultiPolygon) IsValidDetail() *ValidError {
+	return validDetail(mp)
+}
+
 // IsCorrect returns true if the geometry struct is Correct.
 func (mp MultiPolygon) IsCorrect() bool {
 	for _, v := range mp {
@@ -269,6 +287,15 @@ func (mp MultiPolygon) Filter(f filter.Filter[matrix.Matrix]) Geometry {
 	return mPoly
 }
 
+// Transform Returns this MultiPolygon transformed by the affine transformation.
+func (mp MultiPolygon) Transform(t *matrix.AffineTransformation) Geometry {
+	result := make(MultiPolygon, 0, len(mp))
+	for _, p := range mp {
+		result = append(result, p.Transform(t).(Polygon))
+	}
+	return result
+}
+
 // Geom return Geometry without Coordinate System.
 func (mp MultiPolygon) Geom() Geometry {
 	return mp
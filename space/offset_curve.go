@@ -0,0 +1,39 @@
+package space
+
+import (
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// OffsetCurve Returns the line parallel to this LineString at the distance,
+// a positive distance indicates the left side and a negative distance the right side.
+// The corners are joined by the join style and the mitre limit of the parameters,
+// the default parameters are used if params is nil.
+// The offset curve is the part of the buffer boundary on the side, so it is a MultiLineString
+// if it is broken by the inside turns, or an empty LineString if no part of the boundary is on the side.
+func (ls LineString) OffsetCurve(distance float64, params *buffer.CurveParameters) Geometry {
+	if ls.IsEmpty() {
+		return nil
+	}
+	return TransGeometry(buffer.OffsetCurve(matrix.LineMatrix(ls), distance, params))
+}
+
+// OffsetCurve Returns the lines parallel to the lines of this MultiLineString at the distance,
+// a positive distance indicates the left side and a negative distance the right side.
+func (mls MultiLineString) OffsetCurve(distance float64, params *buffer.CurveParameters) Geometry {
+	if mls.IsEmpty() {
+		return nil
+	}
+	result := make(MultiLineString, 0, len(mls))
+	for _, ls := range mls {
+		switch offset := ls.OffsetCurve(distance, params).(type) {
+		case LineString:
+			if !offset.IsEmpty() {
+				result = append(result, offset)
+			}
+		case MultiLineString:
+			result = append(result, offset...)
+		}
+	}
+	return result
+}
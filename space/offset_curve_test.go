@@ -0,0 +1,64 @@
+package space
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/calc"
+)
+
+func TestLineString_OffsetCurve(t *testing.T) {
+	params := &buffer.CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CapFlat, JoinStyle: calc.JoinMitre,
+		MitreLimit: calc.MitreLimit, SimplifyFactor: calc.SimplifyFactor}
+	tests := []struct {
+		name     string
+		ls       LineString
+		distance float64
+		want     Geometry
+	}{
+		{name: "line left", ls: LineString{{0, 0}, {10, 0}, {10, 10}}, distance: 1,
+			want: LineString{{0, 1}, {9, 1}, {9, 10}}},
+		{name: "line right", ls: LineString{{0, 0}, {10, 0}, {10, 10}}, distance: -1,
+			want: LineString{{0, -1}, {11, -1}, {11, 10}}},
+		{name: "sharp inside turn", ls: LineString{{0, 0}, {10, 0}, {10, 1}, {20, 1}}, distance: 3,
+			want: LineString{{0, 3}, {7, 3}, {7, 4}, {20, 4}}},
+		{name: "u-turn inside", ls: LineString{{0, 0}, {10, 0}, {10, 10}, {0, 10}}, distance: 2,
+			want: LineString{{0, 2}, {8, 2}, {8, 8}, {0, 8}}},
+		{name: "u-turn wider than turn", ls: LineString{{0, 0}, {10, 0}, {10, 10}, {0, 10}}, distance: 6,
+			want: LineString{}},
+		{name: "folded line", ls: LineString{{0, 0}, {10, 0}, {10, 4}, {5, 4}, {5, -4}}, distance: 1,
+			want: MultiLineString{{{0, 1}, {4, 1}}, {{6, 1}, {9, 1}, {9, 3}, {6, 3}, {6, 1}}, {{6, -1}, {6, -4}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ls.OffsetCurve(tt.distance, params); !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("OffsetCurve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiLineString_OffsetCurve(t *testing.T) {
+	params := &buffer.CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CapFlat, JoinStyle: calc.JoinMitre,
+		MitreLimit: calc.MitreLimit, SimplifyFactor: calc.SimplifyFactor}
+	tests := []struct {
+		name     string
+		mls      MultiLineString
+		distance float64
+		want     Geometry
+	}{
+		{name: "multi line", mls: MultiLineString{{{0, 0}, {10, 0}}, {{0, 5}, {10, 5}}}, distance: 1,
+			want: MultiLineString{{{0, 1}, {10, 1}}, {{0, 6}, {10, 6}}}},
+		{name: "multi line with u-turn", mls: MultiLineString{{{0, 0}, {10, 0}, {10, 10}, {0, 10}}, {{20, 0}, {30, 0}}}, distance: 6,
+			want: MultiLineString{{{20, 6}, {30, 6}}}},
+		{name: "multi line with folded line", mls: MultiLineString{{{0, 0}, {10, 0}, {10, 4}, {5, 4}, {5, -4}}}, distance: 1,
+			want: MultiLineString{{{0, 1}, {4, 1}}, {{6, 1}, {9, 1}, {9, 3}, {6, 3}, {6, 1}}, {{6, -1}, {6, -4}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mls.OffsetCurve(tt.distance, params); !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("OffsetCurve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
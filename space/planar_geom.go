@@ -43,23 +43,10 @@ func (p *PlanarGeom[T]) bufferInMeter(width float64, quadsegs int) Geometry {
 	case TypeLineString:
 		ls := p.geom.Geom().(LineString)
 		distances := make([]float64, len(ls))
-
 		for i := range distances {
-			distances[i] = measure.MercatorDistance(width, Point(ls[i]).Lat())
+			distances[i] = width
 		}
-
-		transformer := coordtransform.NewTransformer(coordtransform.LLTOMERCATOR)
-		geomMatrix, _ := transformer.TransformGeometry(ls.ToMatrix())
-
-		lbuffer := &buffer.VariableLineBuffer{Line: geomMatrix.(matrix.LineMatrix), QuadrantSegments: quadsegs}
-		resultMatrix := lbuffer.DistancesBuffer(distances)
-		geometry := TransGeometry(resultMatrix)
-		if geometry != nil {
-			transformer.CoordType = coordtransform.MERCATORTOLL
-			geomMatrix, _ = transformer.TransformGeometry(geometry.ToMatrix())
-			geometry = TransGeometry(geomMatrix)
-		}
-		return geometry
+		return variableBufferInMeter(ls, distances, quadsegs)
 	default:
 		centroid := p.geom.Centroid()
 		width = measure.MercatorDistance(width, centroid.Lat())
@@ -76,12 +63,51 @@ func (p *PlanarGeom[T]) bufferInMeter(width float64, quadsegs int) Geometry {
 	}
 }
 
+// variableBuffer returns the buffer of line with the distances at the points.
+func variableBuffer(ls LineString, distances []float64, quadsegs int) Geometry {
+	lbuffer := &buffer.VariableLineBuffer{Line: ls.ToMatrix().(matrix.LineMatrix), QuadrantSegments: quadsegs}
+	return TransGeometry(lbuffer.DistancesBuffer(distances))
+}
+
+// variableBufferInMeter returns the buffer of line with the distances in meter at the points,
+// the buffer is computed in Mercator projection.
+func variableBufferInMeter(ls LineString, distances []float64, quadsegs int) Geometry {
+	mercatorDistances := make([]float64, len(distances))
+	for i := range distances {
+		mercatorDistances[i] = measure.MercatorDistance(distances[i], Point(ls[i]).Lat())
+	}
+
+	// the transformer changes the points in place, so a copy of line is transformed.
+	line := make(matrix.LineMatrix, len(ls))
+	for i, v := range ls {
+		line[i] = append([]float64{}, v...)
+	}
+	transformer := coordtransform.NewTransformer(coordtransform.LLTOMERCATOR)
+	geomMatrix, _ := transformer.TransformGeometry(line)
+
+	lbuffer := &buffer.VariableLineBuffer{Line: geomMatrix.(matrix.LineMatrix), QuadrantSegments: quadsegs}
+	resultMatrix := lbuffer.DistancesBuffer(mercatorDistances)
+	geometry := TransGeometry(resultMatrix)
+	if geometry != nil {
+		transformer.CoordType = coordtransform.MERCATORTOLL
+		geomMatrix, _ = transformer.TransformGeometry(geometry.ToMatrix())
+		geometry = TransGeometry(geomMatrix)
+	}
+	return geometry
+}
+
 // bufferInOriginal ...
 func (p *PlanarGeom[T]) bufferInOriginal(width float64, quadsegs int) Geometry {
-	buff := buffer.Buffer(p.geom.ToMatrix(), width, quadsegs)
+	params := buffer.DefaultCurveParameters()
+	params.QuadrantSegments = quadsegs
+	return p.bufferWithParams(width, params)
+}
+
+// bufferWithParams ...
+func (p *PlanarGeom[T]) bufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	buff := buffer.BufferWithParams(p.geom.ToMatrix(), width, params)
 
-	result := buff
-	switch b := result.(type) {
+	switch b := buff.(type) {
 	case matrix.LineMatrix:
 		return LineString(b)
 	case matrix.PolygonMatrix:
@@ -62,6 +62,11 @@ func (p Point) X() float64 {
 	return p[0]
 }
 
+// M returns the measure of the point, which is its fourth ordinate {x, y, z, m}, returns NaN if it has no measure.
+func (p Point) M() float64 {
+	return matrix.Matrix(p).M()
+}
+
 // EqualsPoint checks if the point represents the same point or vector.
 func (p Point) EqualsPoint(point Point) bool {
 	return matrix.Matrix(p).Equals(matrix.Matrix(point))
@@ -184,6 +189,14 @@ func (p Point) BufferInMeter(width float64, quadsegs int) Geometry {
 	return pg.bufferInMeter(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the parameters specify the end cap style, the join style, the mitre limit and whether the buffer is single sided.
+func (p Point) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	pg := PlanarGeom[Point]{p}
+	return pg.bufferWithParams(width, params)
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -223,6 +236,16 @@ func (p Point) IsValid() bool {
 	return p.IsCorrect()
 }
 
+// IsValidReason returns the reason why the geometry is invalid with the location, or "Valid Geometry".
+func (p Point) IsValidReason() string {
+	return validReason(p)
+}
+
+// IsValidDetail returns the kind and location of the invalidity, returns nil if the geometry is valid.
+func (p Point) IsValidDetail() *ValidError {
+	return validDetail(p)
+}
+
 // IsCorrect returns true if the geometry struct is Correct.
 func (p Point) IsCorrect() bool {
 	return len(p) >= 2
@@ -238,6 +261,11 @@ func (p Point) Filter(f filter.Filter[matrix.Matrix]) Geometry {
 	return p
 }
 
+// Transform Returns this Point transformed by the affine transformation.
+func (p Point) Transform(t *matrix.AffineTransformation) Geometry {
+	return Point(t.TransformMatrix(matrix.Matrix(p)))
+}
+
 // Geom return Geometry without Coordinate System.
 func (p Point) Geom() Geometry {
 	return p
@@ -253,6 +253,14 @@ func (p Polygon) BufferInMeter(width float64, quadsegs int) Geometry {
 	return pg.bufferInMeter(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the parameters specify the end cap style, the join style, the mitre limit and whether the buffer is single sided.
+func (p Polygon) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	pg := PlanarGeom[Polygon]{p}
+	return pg.bufferWithParams(width, params)
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -300,6 +308,16 @@ func (p Polygon) IsValid() bool {
 	return true
 }
 
+// IsValidReason returns the reason why the geometry is invalid with the location, or "Valid Geometry".
+func (p Polygon) IsValidReason() string {
+	return validReason(p)
+}
+
+// IsValidDetail returns the kind and location of the invalidity, returns nil if the geometry is valid.
+func (p Polygon) IsValidDetail() *ValidError {
+	return validDetail(p)
+}
+
 // IsCorrect returns true if the geometry struct is Correct.
 func (p Polygon) IsCorrect() bool {
 	if p.IsEmpty() {
@@ -329,6 +347,12 @@ func (p Polygon) Filter(f filter.Filter[matrix.Matrix]) Geometry {
 	return poly
 }
 
+// Transform Returns this Polygon transformed by the affine transformation,
+// the orientation of rings is reversed if the transformation is a reflection.
+func (p Polygon) Transform(t *matrix.AffineTransformation) Geometry {
+	return Polygon(t.Transform(matrix.PolygonMatrix(p)).(matrix.PolygonMatrix))
+}
+
 // Geom return Geometry without Coordinate System.
 func (p Polygon) Geom() Geometry {
 	return p
@@ -1,6 +1,7 @@
 package space
 
 import (
+	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/filter"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
@@ -146,6 +147,13 @@ func (r Ring) BufferInMeter(width float64, quadsegs int) Geometry {
 	return LineString(r).BufferInMeter(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the parameters specify the end cap style, the join style, the mitre limit and whether the buffer is single sided.
+func (r Ring) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	return LineString(r).BufferWithParams(width, params)
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -183,6 +191,16 @@ func (r Ring) IsValid() bool {
 	return LineString(r).IsValid() && (!r.IsEmpty()) && r.IsRing()
 }
 
+// IsValidReason returns the reason why the geometry is invalid with the location, or "Valid Geometry".
+func (r Ring) IsValidReason() string {
+	return validReason(r)
+}
+
+// IsValidDetail returns the kind and location of the invalidity, returns nil if the geometry is valid.
+func (r Ring) IsValidDetail() *ValidError {
+	return validDetail(r)
+}
+
 // IsCorrect returns true if the geometry struct is Correct.
 func (r Ring) IsCorrect() bool {
 	return LineString(r).IsCorrect() && (!r.IsEmpty())
@@ -200,6 +218,11 @@ func (r Ring) Filter(f filter.Filter[matrix.Matrix]) Geometry {
 
 }
 
+// Transform Returns this Ring transformed by the affine transformation.
+func (r Ring) Transform(t *matrix.AffineTransformation) Geometry {
+	return Ring(LineString(r).Transform(t).(LineString))
+}
+
 // Geom return Geometry without Coordinate System.
 func (r Ring) Geom() Geometry {
 	return r
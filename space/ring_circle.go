@@ -4,6 +4,7 @@ import (
 	"math"
 
 	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
 // Circle describes a circle Valid
@@ -109,6 +110,27 @@ func (c *Circle) IsValid() bool {
 	return true
 }
 
+// Transform Returns this circle transformed by the affine transformation.
+// It is a circle if the transformation keeps the shape, which is composed of translation, rotation,
+// reflection and uniform scaling, otherwise it is the transformed Polygon of circle.
+func (c *Circle) Transform(t *matrix.AffineTransformation) Geometry {
+	poly := c.Polygon.Transform(t).(Polygon)
+	m := t.Matrix()
+	// the columns of linear part are orthogonal and have the same length.
+	scale2 := m[0][0]*m[0][0] + m[1][0]*m[1][0]
+	tolerance := calc.DefaultTolerance * math.Max(1, scale2)
+	if scale2 == 0 || math.Abs(m[0][1]*m[0][1]+m[1][1]*m[1][1]-scale2) > tolerance ||
+		math.Abs(m[0][0]*m[0][1]+m[1][0]*m[1][1]) > tolerance {
+		return poly
+	}
+	return &Circle{
+		Polygon:  poly,
+		Centre:   c.Centre.Transform(t).(Point),
+		Radius:   c.Radius * math.Sqrt(scale2),
+		Segments: c.Segments,
+	}
+}
+
 // Geom return Geometry without Coordinate System.
 func (c *Circle) Geom() Geometry {
 	return c
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
 func TestCreateCircle(t *testing.T) {
@@ -32,3 +33,20 @@ func TestCreateCircle(t *testing.T) {
 		})
 	}
 }
+
+func TestCircle_Transform(t *testing.T) {
+	circle, _ := CreateCircleWithSegments(Point{1, 1}, 2, 4)
+
+	got, ok := circle.Transform(matrix.NewAffineTransformation().Scale(3, 3).RotateAround(1, 0, 0)).(*Circle)
+	if !ok {
+		t.Fatalf("Transform() should be a circle")
+	}
+	want := Point{1, 1}.Transform(matrix.NewAffineTransformation().Scale(3, 3).RotateAround(1, 0, 0))
+	if !got.Centre.EqualsExact(want, 0.000001) || got.Radius != 6 || got.Segments != 4 {
+		t.Errorf("Transform() = %v %v, want %v %v", got.Centre, got.Radius, want, 6)
+	}
+
+	if _, ok := circle.Transform(matrix.NewAffineTransformation().Scale(2, 1)).(Polygon); !ok {
+		t.Errorf("Transform() should be a polygon if the circle is not kept")
+	}
+}
@@ -14,6 +14,27 @@ var ErrNotValidGeometry = fmt.Errorf("Geometry is not valid")
 // ErrNotPolygon UnaryUnion parameter is not polygon
 var ErrNotPolygon = fmt.Errorf("Geometry is not polygon")
 
+// ErrNotLineString ...
+var ErrNotLineString = fmt.Errorf("Geometry is not linestring")
+
+// ErrWrongDistances ...
+var ErrWrongDistances = fmt.Errorf("The number of distances should be equal to the number of points")
+
+// ErrWrongRadius ...
+var ErrWrongRadius = fmt.Errorf("The radius of circle should be positive")
+
+// ErrWrongFraction ...
+var ErrWrongFraction = fmt.Errorf("The fraction should be in the range [0, 1] and the start should not be larger than the end")
+
+// ErrNotMeasured ...
+var ErrNotMeasured = fmt.Errorf("Geometry has no measure")
+
+// ErrWrongCalibration ...
+var ErrWrongCalibration = fmt.Errorf("The calibration points should have measures at two different locations at least")
+
+// ErrWrongSegmentLength ...
+var ErrWrongSegmentLength = fmt.Errorf("The max segment length should be positive")
+
 // ErrNotSupportCollection ...
 var ErrNotSupportCollection = fmt.Errorf("Operation does not support GeometryCollection arguments")
 
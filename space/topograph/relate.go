@@ -2,6 +2,8 @@ package topograph
 
 import (
 	"github.com/spatial-go/geoos/algorithm/graph/de9im"
+	"github.com/spatial-go/geoos/algorithm/prepared"
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
 )
 
@@ -17,6 +19,24 @@ func (t *Topograph) Relate(A, B space.Geometry) (string, error) {
 
 }
 
+// RelateBoundaryNodeRule Computes the  Intersection Matrix for the spatial relationship
+// between two geometries, using the Boundary Node Rule to determine the boundaries of lineal geometries.
+// The geometries are noded by each other, so the collections are supported.
+func (t *Topograph) RelateBoundaryNodeRule(A, B space.Geometry, rule relate.BoundaryNodeRule) (string, error) {
+	return relate.RelateBoundaryNodeRule(A.ToMatrix(), B.ToMatrix(), rule)
+}
+
+// RelatePattern returns TRUE if the Intersection Matrix for the spatial relationship
+// between two geometries matches the pattern, e.g. "T*F**F***".
+// The matrix is the one of RelateBoundaryNodeRule with the Mod-2 Boundary Node Rule.
+func (t *Topograph) RelatePattern(A, B space.Geometry, pattern string) (bool, error) {
+	im, err := relate.IMBoundaryNodeRule(A.ToMatrix(), B.ToMatrix(), relate.Mod2BoundaryNodeRule)
+	if err != nil {
+		return false, err
+	}
+	return im.Matches(pattern)
+}
+
 // Within returns TRUE if geometry A is completely inside geometry B.
 // For this function to make sense, the source geometries must both be of the same coordinate projection,
 // having the same SRID.
@@ -44,6 +64,15 @@ func (t *Topograph) Contains(A, B space.Geometry) (bool, error) {
 	return im.IsContains(), nil
 }
 
+// ContainsProperly returns TRUE if geometry B is in the interior of geometry A,
+// that is, B doesn't touch the boundary of A, so a geometry doesn't contain itself properly.
+func (t *Topograph) ContainsProperly(A, B space.Geometry) (bool, error) {
+	if m := A.ToMatrix(); prepared.IsPolygonal(m) {
+		return prepared.NewPreparedGeometry(m).ContainsProperly(B.ToMatrix()), nil
+	}
+	return t.RelatePattern(A, B, "T**FF*FF*")
+}
+
 // Covers returns TRUE if no point in space.Geometry B is outside space.Geometry A
 func (t *Topograph) Covers(A, B space.Geometry) (bool, error) {
 	_, isAInB, isSure := AInB(B, A)
@@ -3,6 +3,7 @@ package topograph
 import (
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
 )
 
@@ -248,3 +249,86 @@ func TestTopological_Overlaps(t *testing.T) {
 		})
 	}
 }
+
+func TestTopological_ContainsProperly(t *testing.T) {
+	square := space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"ContainsProperly 0", args{square, space.Polygon{{{1, 1}, {2, 1}, {2, 2}, {1, 1}}}}, true, false},
+		{"ContainsProperly 1", args{square, square}, false, false},
+		{"ContainsProperly 2", args{square, space.LineString{{0, 0}, {5, 5}}}, false, false},
+		{"ContainsProperly 3", args{space.LineString{{1, 1}, {3, 3}}, space.Point{2, 2}}, true, false},
+		{"ContainsProperly 4", args{space.LineString{{1, 1}, {3, 3}}, space.Point{1, 1}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tr.ContainsProperly(tt.args.A, tt.args.B)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Topological.ContainsProperly() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Topological.ContainsProperly() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopological_RelatePattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    args
+		pattern string
+		want    bool
+		wantErr bool
+	}{
+		{"RelatePattern 0", args{space.Point{2, 2}, space.LineString{{1, 1}, {3, 3}}}, "T*F**F***", true, false},
+		{"RelatePattern 1", args{space.Point{1, 1}, space.LineString{{1, 1}, {3, 3}}}, "T*F**F***", false, false},
+		{"RelatePattern 2", args{space.Point{2, 2}, space.LineString{{1, 1}, {3, 3}}}, "T*F", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tr.RelatePattern(tt.args.A, tt.args.B, tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Topological.RelatePattern() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Topological.RelatePattern() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopological_RelateBoundaryNodeRule(t *testing.T) {
+	joined := space.MultiLineString{{{0, 0}, {1, 1}}, {{1, 1}, {2, 2}}}
+	tests := []struct {
+		name    string
+		args    args
+		rule    relate.BoundaryNodeRule
+		want    string
+		wantErr bool
+	}{
+		{"Mod2", args{joined, space.Point{1, 1}}, relate.Mod2BoundaryNodeRule, "0F1FF0FF2", false},
+		{"EndPoint", args{joined, space.Point{1, 1}}, relate.EndPointBoundaryNodeRule, "FF10F0FF2", false},
+		{"MultivalentEndPoint", args{joined, space.Point{1, 1}}, relate.MultivalentEndPointBoundaryNodeRule, "FF10FFFF2", false},
+		{"MonovalentEndPoint", args{joined, space.Point{1, 1}}, relate.MonovalentEndPointBoundaryNodeRule, "0F1FF0FF2", false},
+		{"Unknown", args{joined, space.Point{1, 1}}, relate.BoundaryNodeRule(-1), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tr.RelateBoundaryNodeRule(tt.args.A, tt.args.B, tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Topological.RelateBoundaryNodeRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Topological.RelateBoundaryNodeRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
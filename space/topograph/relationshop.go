@@ -4,6 +4,7 @@ package topograph
 import (
 	"sync"
 
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
 )
 
@@ -37,6 +38,14 @@ type Relationship interface {
 	// between two geometries, using the default (OGC SFS) Boundary Node Rule
 	Relate(A, B space.Geometry) (string, error)
 
+	// RelateBoundaryNodeRule Computes the  Intersection Matrix for the spatial relationship
+	// between two geometries, using the Boundary Node Rule to determine the boundaries of lineal geometries.
+	RelateBoundaryNodeRule(A, B space.Geometry, rule relate.BoundaryNodeRule) (string, error)
+
+	// RelatePattern returns TRUE if the Intersection Matrix for the spatial relationship
+	// between two geometries matches the pattern, e.g. "T*F**F***".
+	RelatePattern(A, B space.Geometry, pattern string) (bool, error)
+
 	// Within returns TRUE if geometry A is completely inside geometry B.
 	// For this function to make sense, the source geometries must both be of the same coordinate projection,
 	// having the same SRID.
@@ -50,6 +59,10 @@ type Relationship interface {
 	// having the same SRID.
 	Contains(A, B space.Geometry) (bool, error)
 
+	// ContainsProperly returns TRUE if geometry B is in the interior of geometry A,
+	// that is, B doesn't touch the boundary of A, so a geometry doesn't contain itself properly.
+	ContainsProperly(A, B space.Geometry) (bool, error)
+
 	// Covers returns TRUE if no point in space.Geometry B is outside space.Geometry A
 	Covers(A, B space.Geometry) (bool, error)
 
@@ -39,6 +39,24 @@ func (t *Topological) Relate(A, B space.Geometry) (string, error) {
 	return im.ToString(), nil
 }
 
+// RelateBoundaryNodeRule Computes the  Intersection Matrix for the spatial relationship
+// between two geometries, using the Boundary Node Rule to determine the boundaries of lineal geometries.
+// The geometries are noded by each other, so the collections are supported.
+func (t *Topological) RelateBoundaryNodeRule(A, B space.Geometry, rule relate.BoundaryNodeRule) (string, error) {
+	return relate.RelateBoundaryNodeRule(A.ToMatrix(), B.ToMatrix(), rule)
+}
+
+// RelatePattern returns TRUE if the Intersection Matrix for the spatial relationship
+// between two geometries matches the pattern, e.g. "T*F**F***".
+// The matrix is the one of RelateBoundaryNodeRule with the Mod-2 Boundary Node Rule.
+func (t *Topological) RelatePattern(A, B space.Geometry, pattern string) (bool, error) {
+	im, err := relate.IMBoundaryNodeRule(A.ToMatrix(), B.ToMatrix(), relate.Mod2BoundaryNodeRule)
+	if err != nil {
+		return false, err
+	}
+	return im.Matches(pattern)
+}
+
 // Within returns TRUE if geometry A is completely inside geometry B.
 // For this func (t *Topological)tion to make sense, the source geometries must both be of the same coordinate projection,
 // having the same SRID.
@@ -66,6 +84,12 @@ func (t *Topological) Contains(A, B space.Geometry) (bool, error) {
 	return im.IsContains(), nil
 }
 
+// ContainsProperly returns TRUE if geometry B is in the interior of geometry A,
+// that is, B doesn't touch the boundary of A, so a geometry doesn't contain itself properly.
+func (t *Topological) ContainsProperly(A, B space.Geometry) (bool, error) {
+	return t.RelatePattern(A, B, "T**FF*FF*")
+}
+
 // Covers returns TRUE if no point in space.Geometry B is outside space.Geometry A
 func (t *Topological) Covers(A, B space.Geometry) (bool, error) {
 	isIntersect, isAInB, isSure := topograph.AInB(B, A)
@@ -0,0 +1,594 @@
+package space
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/operation"
+	"github.com/spatial-go/geoos/algorithm/overlay/chain"
+)
+
+// ValidErrorKind is the kind of the reason why a geometry is invalid.
+type ValidErrorKind int
+
+// const kinds of the reasons why a geometry is invalid.
+const (
+	// InvalidCoordinate the coordinate is missing, NaN or infinite.
+	InvalidCoordinate ValidErrorKind = iota + 1
+	// TooFewPoints a line has less than 2 distinct points or a ring has less than 3 distinct points.
+	TooFewPoints
+	// RingNotClosed the first point and the last point of a ring are not the same.
+	RingNotClosed
+	// SelfIntersection the rings cross or overlap each other or themselves.
+	SelfIntersection
+	// RingSelfIntersection a ring touches itself at a point.
+	RingSelfIntersection
+	// HoleOutsideShell a hole of polygon lies outside the shell.
+	HoleOutsideShell
+	// NestedHoles a hole of polygon lies inside another hole.
+	NestedHoles
+	// DisconnectedInterior the rings of polygon touch each other and split the interior.
+	DisconnectedInterior
+	// NestedShells a polygon of multi polygon lies inside another polygon.
+	NestedShells
+)
+
+var validErrorMessages = map[ValidErrorKind]string{
+	InvalidCoordinate:    "Invalid Coordinate",
+	TooFewPoints:         "Too few points in geometry component",
+	RingNotClosed:        "Ring is not closed",
+	SelfIntersection:     "Self-intersection",
+	RingSelfIntersection: "Ring Self-intersection",
+	HoleOutsideShell:     "Hole lies outside shell",
+	NestedHoles:          "Holes are nested",
+	DisconnectedInterior: "Interior is disconnected",
+	NestedShells:         "Nested shells",
+}
+
+// String returns the message of kind.
+func (k ValidErrorKind) String() string {
+	if msg, ok := validErrorMessages[k]; ok {
+		return msg
+	}
+	return "Unknown validation error"
+}
+
+// ValidReasonValid is the reason of a valid geometry.
+const ValidReasonValid = "Valid Geometry"
+
+// ValidError describes why and where a geometry is invalid.
+type ValidError struct {
+	Kind ValidErrorKind
+	// Point is the location of the error, it is nil if there is no location.
+	Point Point
+}
+
+// Error returns the message of error with the location, e.g. "Self-intersection[1 1]".
+func (e *ValidError) Error() string {
+	if len(e.Point) < 2 {
+		return e.Kind.String()
+	}
+	return fmt.Sprintf("%v[%v %v]", e.Kind, e.Point[0], e.Point[1])
+}
+
+// validReason returns the reason why the geometry is invalid, or "Valid Geometry".
+func validReason(geom Geometry) string {
+	if err := validDetail(geom); err != nil {
+		return err.Error()
+	}
+	return ValidReasonValid
+}
+
+// validDetail returns the reason and location why the geometry is invalid, returns nil if the geometry is valid.
+// The empty geometry is valid.
+func validDetail(geom Geometry) *ValidError {
+	if geom == nil {
+		return nil
+	}
+	switch g := geom.Geom().(type) {
+	case Point:
+		return validPoint(g)
+	case MultiPoint:
+		for _, v := range g {
+			if err := validPoint(v); err != nil {
+				return err
+			}
+		}
+	case LineString:
+		return validLine(g)
+	case MultiLineString:
+		for _, v := range g {
+			if err := validLine(v); err != nil {
+				return err
+			}
+		}
+	case Ring:
+		return validPolygon(Polygon{g})
+	case Polygon:
+		return validPolygon(g)
+	case *Circle:
+		return validPolygon(g.Polygon)
+	case MultiPolygon:
+		return validMultiPolygon(g)
+	case Bound:
+		if err := validPoint(g.Min); err != nil {
+			return err
+		}
+		return validPoint(g.Max)
+	case Collection:
+		for _, v := range g {
+			if err := validDetail(v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validPoint(p Point) *ValidError {
+	if len(p) == 0 {
+		return nil
+	}
+	if len(p) < 2 {
+		return &ValidError{Kind: InvalidCoordinate}
+	}
+	for _, v := range p {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return &ValidError{Kind: InvalidCoordinate, Point: p}
+		}
+	}
+	return nil
+}
+
+func validLine(ls LineString) *ValidError {
+	if len(ls) == 0 {
+		return nil
+	}
+	for _, v := range ls {
+		if len(v) < 2 {
+			return &ValidError{Kind: InvalidCoordinate}
+		}
+		if err := validPoint(v); err != nil {
+			return err
+		}
+	}
+	if len(removeRepeatedPoints(ls)) < 2 {
+		return &ValidError{Kind: TooFewPoints, Point: ls[0]}
+	}
+	return nil
+}
+
+// validRings checks the coordinates, the closure and the number of points of rings,
+// returns the rings without repeated points.
+func validRings(p Polygon) ([]LineString, *ValidError) {
+	rings := make([]LineString, 0, len(p))
+	for _, r := range p {
+		ring := LineString(r)
+		if err := validLine(ring); err != nil {
+			return nil, err
+		}
+		if len(ring) == 0 {
+			continue
+		}
+		if !ring.IsClosed() {
+			return nil, &ValidError{Kind: RingNotClosed, Point: ring[0]}
+		}
+		ring = removeRepeatedPoints(ring)
+		if len(ring) < 4 {
+			return nil, &ValidError{Kind: TooFewPoints, Point: ring[0]}
+		}
+		rings = append(rings, ring)
+	}
+	return rings, nil
+}
+
+func validPolygon(p Polygon) *ValidError {
+	rings, err := validRings(p)
+	if err != nil || len(rings) == 0 {
+		return err
+	}
+	for _, ring := range rings {
+		if err := validRingSelfIntersection(ring); err != nil {
+			return err
+		}
+	}
+
+	// the rings must not cross, and the rings touching each other must not form a cycle.
+	touches := newUnionFind(len(rings))
+	for i := range rings {
+		for j := i + 1; j < len(rings); j++ {
+			points, err := ringsTouchPoints(rings[i], rings[j])
+			if err != nil {
+				return err
+			}
+			for _, pt := range points {
+				if !touches.union(i, j) {
+					return &ValidError{Kind: DisconnectedInterior, Point: pt}
+				}
+			}
+		}
+	}
+
+	shell := rings[0]
+	for i, hole := range rings[1:] {
+		if pt := pointNotOnRing(hole, shell); pt != nil && !operation.IsPnPolygon(matrix.Matrix(pt), matrix.LineMatrix(shell)) {
+			return &ValidError{Kind: HoleOutsideShell, Point: pt}
+		}
+		for j, other := range rings[1:] {
+			if i == j {
+				continue
+			}
+			if pt := pointNotOnRing(hole, other); pt != nil && operation.IsPnPolygon(matrix.Matrix(pt), matrix.LineMatrix(other)) {
+				return &ValidError{Kind: NestedHoles, Point: pt}
+			}
+		}
+	}
+	return nil
+}
+
+func validMultiPolygon(mp MultiPolygon) *ValidError {
+	polys := make([][]LineString, 0, len(mp))
+	for _, p := range mp {
+		if err := validPolygon(p); err != nil {
+			return err
+		}
+		rings, _ := validRings(p)
+		if len(rings) > 0 {
+			polys = append(polys, rings)
+		}
+	}
+	for i := range polys {
+		for j := range polys {
+			if i == j {
+				continue
+			}
+			if j > i {
+				for _, r1 := range polys[i] {
+					for _, r2 := range polys[j] {
+						if _, err := ringsTouchPoints(r1, r2); err != nil {
+							return err
+						}
+					}
+				}
+			}
+			// the shell lies in the interior of other polygon.
+			shell, other := polys[i][0], polys[j]
+			pt := pointNotOnRing(shell, other[0])
+			if pt == nil || !operation.IsPnPolygon(matrix.Matrix(pt), matrix.LineMatrix(other[0])) {
+				continue
+			}
+			inHole := false
+			for _, hole := range other[1:] {
+				if operation.IsPnPolygon(matrix.Matrix(pt), matrix.LineMatrix(hole)) {
+					inHole = true
+					break
+				}
+			}
+			if !inHole {
+				return &ValidError{Kind: NestedShells, Point: pt}
+			}
+		}
+	}
+	return nil
+}
+
+// validRingSelfIntersection checks the segments of ring, the crossing and overlapping segments are self-intersection,
+// the segments touching at a point other than their shared vertex are ring self-intersection,
+// unless the ring crosses itself at the point.
+func validRingSelfIntersection(ring LineString) *ValidError {
+	n := len(ring) - 1
+	nodes := &touchNodes{index: map[[2]float64]int{}}
+	for _, pair := range candidateSegments(ring, ring, true) {
+		i, j := pair[0], pair[1]
+		kind, pt := segmentIntersection(ring[i], ring[i+1], ring[j], ring[j+1])
+		adjacent := j == i+1 || (i == 0 && j == n-1)
+		switch {
+		case kind == segmentsCross || kind == segmentsOverlap:
+			return &ValidError{Kind: SelfIntersection, Point: pt}
+		case kind == segmentsTouch && !adjacent:
+			nodes.add(pt, i, j)
+		}
+	}
+	for _, node := range nodes.nodes {
+		passes := ringPasses(ring, append(append([]int{}, node.segs[0]...), node.segs[1]...), node.pt)
+		for i := range passes {
+			for j := i + 1; j < len(passes); j++ {
+				if passesCross(node.pt, passes[i], passes[j]) {
+					return &ValidError{Kind: SelfIntersection, Point: node.pt}
+				}
+			}
+		}
+	}
+	if len(nodes.nodes) > 0 {
+		return &ValidError{Kind: RingSelfIntersection, Point: nodes.nodes[0].pt}
+	}
+	return nil
+}
+
+// ringsTouchPoints returns the distinct points where the rings touch,
+// returns self-intersection error if the rings cross or overlap, at a vertex as well.
+func ringsTouchPoints(r1, r2 LineString) ([]Point, *ValidError) {
+	if !r1.Bound().IntersectsBound(r2.Bound()) {
+		return nil, nil
+	}
+	nodes := &touchNodes{index: map[[2]float64]int{}}
+	for _, pair := range candidateSegments(r1, r2, false) {
+		i, j := pair[0], pair[1]
+		kind, pt := segmentIntersection(r1[i], r1[i+1], r2[j], r2[j+1])
+		switch kind {
+		case segmentsCross, segmentsOverlap:
+			return nil, &ValidError{Kind: SelfIntersection, Point: pt}
+		case segmentsTouch:
+			nodes.add(pt, i, j)
+		}
+	}
+	points := make([]Point, 0, len(nodes.nodes))
+	for _, node := range nodes.nodes {
+		for _, p1 := range ringPasses(r1, node.segs[0], node.pt) {
+			for _, p2 := range ringPasses(r2, node.segs[1], node.pt) {
+				if passesCross(node.pt, p1, p2) {
+					return nil, &ValidError{Kind: SelfIntersection, Point: node.pt}
+				}
+			}
+		}
+		points = append(points, node.pt)
+	}
+	return points, nil
+}
+
+// candidateSegments returns the pairs of segment indexes of the rings which may intersect,
+// the segments are indexed by monotone chains and the pairs are sorted.
+// If self is true the rings are the same one and each pair is returned once.
+func candidateSegments(r1, r2 LineString, self bool) [][2]int {
+	collector := &segmentPairs{self: self}
+	intersector := &chain.SegmentMutualIntersector{SegmentMutual: matrix.LineMatrix(r1)}
+	intersector.Process(matrix.LineMatrix(r2), collector)
+	sort.Slice(collector.pairs, func(i, j int) bool {
+		if collector.pairs[i][0] != collector.pairs[j][0] {
+			return collector.pairs[i][0] < collector.pairs[j][0]
+		}
+		return collector.pairs[i][1] < collector.pairs[j][1]
+	})
+	return collector.pairs
+}
+
+// segmentPairs collects the pairs of segments whose monotone chains overlap.
+type segmentPairs struct {
+	pairs [][2]int
+	self  bool
+}
+
+// ProcessIntersections adds the pair of segments.
+func (s *segmentPairs) ProcessIntersections(
+	e0 matrix.LineMatrix, segIndex0 int,
+	e1 matrix.LineMatrix, segIndex1 int) {
+	if s.self && segIndex0 >= segIndex1 {
+		return
+	}
+	s.pairs = append(s.pairs, [2]int{segIndex0, segIndex1})
+}
+
+// IsDone Always process all pairs.
+func (s *segmentPairs) IsDone() bool {
+	return false
+}
+
+// Result returns the pairs of segments.
+func (s *segmentPairs) Result() interface{} {
+	return s.pairs
+}
+
+// touchNode is a point where the rings touch, with the segments of both rings containing it.
+type touchNode struct {
+	pt   Point
+	segs [2][]int
+}
+
+// touchNodes is the touch points in the order found.
+type touchNodes struct {
+	nodes []*touchNode
+	index map[[2]float64]int
+}
+
+func (t *touchNodes) add(pt Point, seg0, seg1 int) {
+	key := [2]float64{pt[0], pt[1]}
+	i, ok := t.index[key]
+	if !ok {
+		i = len(t.nodes)
+		t.index[key] = i
+		t.nodes = append(t.nodes, &touchNode{pt: pt})
+	}
+	t.nodes[i].segs[0] = append(t.nodes[i].segs[0], seg0)
+	t.nodes[i].segs[1] = append(t.nodes[i].segs[1], seg1)
+}
+
+// ringPasses returns the passes of ring through the point on segments,
+// a pass is the previous and the next point of ring around the point.
+func ringPasses(ring LineString, segs []int, pt Point) [][2]Point {
+	n := len(ring) - 1
+	passes := [][2]Point{}
+	vertices := map[int]bool{}
+	for _, i := range segs {
+		k := -1
+		switch {
+		case pt.EqualsPoint(ring[i]):
+			k = i
+		case pt.EqualsPoint(ring[i+1]):
+			k = (i + 1) % n
+		default:
+			passes = append(passes, [2]Point{ring[i], ring[i+1]})
+			continue
+		}
+		if vertices[k] {
+			continue
+		}
+		vertices[k] = true
+		prev := n - 1
+		if k > 0 {
+			prev = k - 1
+		}
+		passes = append(passes, [2]Point{ring[prev], ring[k+1]})
+	}
+	return passes
+}
+
+// passesCross returns true if the pass b crosses the pass a at point p,
+// that is the points of b lie on different sides of a around p.
+func passesCross(p Point, a, b [2]Point) bool {
+	for _, ray := range a {
+		if onRay(p, ray, b[0]) || onRay(p, ray, b[1]) {
+			return false
+		}
+	}
+	return inSector(p, a[0], a[1], b[0]) != inSector(p, a[0], a[1], b[1])
+}
+
+// inSector returns true if the direction from p to q is in the sector swept counter clockwise
+// from the direction p to a to the direction p to b, q is not on the rays.
+func inSector(p, a, b, q Point) bool {
+	oa := calc.OrientationIndex(p[0], p[1], a[0], a[1], q[0], q[1])
+	ob := calc.OrientationIndex(p[0], p[1], b[0], b[1], q[0], q[1])
+	switch calc.OrientationIndex(p[0], p[1], a[0], a[1], b[0], b[1]) {
+	case calc.CounterClockWise:
+		return oa == calc.CounterClockWise && ob == calc.ClockWise
+	case calc.ClockWise:
+		return !(oa == calc.ClockWise && ob == calc.CounterClockWise)
+	default:
+		return oa == calc.CounterClockWise
+	}
+}
+
+// onRay returns true if q lies on the ray from p through a.
+func onRay(p, a, q Point) bool {
+	return calc.OrientationIndex(p[0], p[1], a[0], a[1], q[0], q[1]) == calc.Collinear &&
+		(a[0]-p[0])*(q[0]-p[0])+(a[1]-p[1])*(q[1]-p[1]) > 0
+}
+
+// const kinds of segments intersection.
+const (
+	segmentsDisjoint = iota
+	segmentsTouch
+	segmentsCross
+	segmentsOverlap
+)
+
+// segmentIntersection returns the kind of intersection of segments a0a1 and b0b1 with an intersection point.
+func segmentIntersection(a0, a1, b0, b1 Point) (int, Point) {
+	o1 := calc.OrientationIndex(a0[0], a0[1], a1[0], a1[1], b0[0], b0[1])
+	o2 := calc.OrientationIndex(a0[0], a0[1], a1[0], a1[1], b1[0], b1[1])
+	o3 := calc.OrientationIndex(b0[0], b0[1], b1[0], b1[1], a0[0], a0[1])
+	o4 := calc.OrientationIndex(b0[0], b0[1], b1[0], b1[1], a1[0], a1[1])
+	if o1 == 0 && o2 == 0 {
+		// collinear segments.
+		points := []Point{}
+		for _, pt := range []Point{b0, b1} {
+			if onSegment(pt, a0, a1) && !containsPoint(points, pt) {
+				points = append(points, pt)
+			}
+		}
+		for _, pt := range []Point{a0, a1} {
+			if onSegment(pt, b0, b1) && !containsPoint(points, pt) {
+				points = append(points, pt)
+			}
+		}
+		switch len(points) {
+		case 0:
+			return segmentsDisjoint, nil
+		case 1:
+			return segmentsTouch, points[0]
+		default:
+			return segmentsOverlap, points[0]
+		}
+	}
+	if o1*o2 < 0 && o3*o4 < 0 {
+		ab := (b0[0]-a0[0])*(b1[1]-b0[1]) - (b0[1]-a0[1])*(b1[0]-b0[0])
+		d := (a1[0]-a0[0])*(b1[1]-b0[1]) - (a1[1]-a0[1])*(b1[0]-b0[0])
+		t := ab / d
+		return segmentsCross, Point{a0[0] + t*(a1[0]-a0[0]), a0[1] + t*(a1[1]-a0[1])}
+	}
+	switch {
+	case o1 == 0 && onSegment(b0, a0, a1):
+		return segmentsTouch, b0
+	case o2 == 0 && onSegment(b1, a0, a1):
+		return segmentsTouch, b1
+	case o3 == 0 && onSegment(a0, b0, b1):
+		return segmentsTouch, a0
+	case o4 == 0 && onSegment(a1, b0, b1):
+		return segmentsTouch, a1
+	}
+	return segmentsDisjoint, nil
+}
+
+// onSegment returns true if the collinear point p lies in the envelope of segment ab.
+func onSegment(p, a, b Point) bool {
+	return p[0] >= math.Min(a[0], b[0]) && p[0] <= math.Max(a[0], b[0]) &&
+		p[1] >= math.Min(a[1], b[1]) && p[1] <= math.Max(a[1], b[1])
+}
+
+// pointNotOnRing returns a vertex of ring that does not lie on the other ring, returns nil if there is none.
+func pointNotOnRing(ring, other LineString) Point {
+	for _, v := range ring {
+		onRing := false
+		for i := 0; i < len(other)-1; i++ {
+			if calc.OrientationIndex(other[i][0], other[i][1], other[i+1][0], other[i+1][1], v[0], v[1]) == 0 && onSegment(v, other[i], other[i+1]) {
+				onRing = true
+				break
+			}
+		}
+		if !onRing {
+			return v
+		}
+	}
+	return nil
+}
+
+func removeRepeatedPoints(ls LineString) LineString {
+	result := make(LineString, 0, len(ls))
+	for _, v := range ls {
+		if len(result) == 0 || !Point(v).EqualsPoint(result[len(result)-1]) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func containsPoint(points []Point, pt Point) bool {
+	for _, v := range points {
+		if v.EqualsPoint(pt) {
+			return true
+		}
+	}
+	return false
+}
+
+// unionFind is the disjoint sets of the rings touching each other.
+type unionFind []int
+
+func newUnionFind(n int) unionFind {
+	u := make(unionFind, n)
+	for i := range u {
+		u[i] = i
+	}
+	return u
+}
+
+func (u unionFind) find(i int) int {
+	for u[i] != i {
+		u[i] = u[u[i]]
+		i = u[i]
+	}
+	return i
+}
+
+// union joins the sets of i and j, returns false if they are already in the same set.
+func (u unionFind) union(i, j int) bool {
+	ri, rj := u.find(i), u.find(j)
+	if ri == rj {
+		return false
+	}
+	u[ri] = rj
+	return true
+}
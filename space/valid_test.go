@@ -0,0 +1,121 @@
+package space
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestGeometry_IsValidDetail(t *testing.T) {
+	shell := Ring{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	tests := []struct {
+		name string
+		geom Geometry
+		want *ValidError
+	}{
+		{name: "valid polygon", geom: Polygon{shell, {{2, 2}, {4, 2}, {4, 4}, {2, 2}}}, want: nil},
+		{name: "empty polygon", geom: Polygon{}, want: nil},
+		{name: "invalid coordinate", geom: LineString{{0, 0}, {math.NaN(), 1}},
+			want: &ValidError{Kind: InvalidCoordinate, Point: Point{math.NaN(), 1}}},
+		{name: "too few points line", geom: LineString{{1, 1}, {1, 1}},
+			want: &ValidError{Kind: TooFewPoints, Point: Point{1, 1}}},
+		{name: "too few points ring", geom: Polygon{{{0, 0}, {1, 1}, {0, 0}}},
+			want: &ValidError{Kind: TooFewPoints, Point: Point{0, 0}}},
+		{name: "ring not closed", geom: Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}},
+			want: &ValidError{Kind: RingNotClosed, Point: Point{0, 0}}},
+		{name: "bow-tie", geom: Polygon{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}},
+			want: &ValidError{Kind: SelfIntersection, Point: Point{1, 1}}},
+		{name: "bow-tie through vertex", geom: Polygon{{{0, 0}, {1, 1}, {2, 2}, {2, 0}, {1, 1}, {0, 2}, {0, 0}}},
+			want: &ValidError{Kind: SelfIntersection, Point: Point{1, 1}}},
+		{name: "spike", geom: Polygon{{{0, 0}, {2, 0}, {3, 0}, {2, 0}, {2, 2}, {0, 0}}},
+			want: &ValidError{Kind: SelfIntersection, Point: Point{3, 0}}},
+		{name: "ring self-intersection", geom: Polygon{{{0, 0}, {4, 0}, {4, 4}, {2, 0}, {0, 4}, {0, 0}}},
+			want: &ValidError{Kind: RingSelfIntersection, Point: Point{2, 0}}},
+		{name: "inverted shell", geom: Polygon{{{0, 0}, {4, 0}, {2, 2}, {3, 4}, {1, 4}, {2, 2}, {0, 4}, {0, 0}}},
+			want: &ValidError{Kind: RingSelfIntersection, Point: Point{2, 2}}},
+		{name: "hole crosses shell", geom: Polygon{shell, {{5, 5}, {15, 5}, {15, 6}, {5, 5}}},
+			want: &ValidError{Kind: SelfIntersection, Point: Point{10, 5}}},
+		{name: "hole crosses shell at vertex", geom: Polygon{shell, {{5, 5}, {10, 2}, {15, 5}, {10, 8}, {5, 5}}},
+			want: &ValidError{Kind: SelfIntersection, Point: Point{10, 2}}},
+		{name: "hole outside shell", geom: Polygon{shell, {{12, 12}, {14, 12}, {14, 14}, {12, 12}}},
+			want: &ValidError{Kind: HoleOutsideShell, Point: Point{12, 12}}},
+		{name: "nested holes", geom: Polygon{shell, {{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}, {{2, 2}, {3, 2}, {3, 3}, {2, 2}}},
+			want: &ValidError{Kind: NestedHoles, Point: Point{2, 2}}},
+		{name: "disconnected interior", geom: Polygon{shell, {{0, 5}, {5, 0}, {10, 5}, {5, 10}, {0, 5}}},
+			want: &ValidError{Kind: DisconnectedInterior, Point: Point{10, 5}}},
+		{name: "hole touches shell", geom: Polygon{shell, {{0, 5}, {5, 1}, {5, 9}, {0, 5}}}, want: nil},
+		{name: "nested shells", geom: MultiPolygon{{shell}, {{{2, 2}, {4, 2}, {4, 4}, {2, 2}}}},
+			want: &ValidError{Kind: NestedShells, Point: Point{2, 2}}},
+		{name: "shell in hole", geom: MultiPolygon{{shell, {{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}}, {{{2, 2}, {4, 2}, {4, 4}, {2, 2}}}},
+			want: nil},
+		{name: "overlapping polygons", geom: MultiPolygon{{shell}, {{{5, 5}, {15, 5}, {15, 15}, {5, 5}}}},
+			want: &ValidError{Kind: SelfIntersection, Point: Point{10, 5}}},
+		{name: "collection", geom: Collection{Point{1, 1}, LineString{{1, 1}, {1, 1}}},
+			want: &ValidError{Kind: TooFewPoints, Point: Point{1, 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.geom.IsValidDetail()
+			if tt.want == nil || got == nil {
+				if got != tt.want {
+					t.Errorf("IsValidDetail() = %v, want %v", got, tt.want)
+				}
+				return
+			}
+			if got.Kind != tt.want.Kind || got.Error() != tt.want.Error() {
+				t.Errorf("IsValidDetail() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometry_IsValid(t *testing.T) {
+	shell := Ring{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	// IsValid checks the structure of geometry, IsValidDetail checks its topology too.
+	tests := []struct {
+		name   string
+		geom   Geometry
+		valid  bool
+		detail bool
+	}{
+		{name: "valid polygon", geom: Polygon{shell, {{2, 2}, {4, 2}, {4, 4}, {2, 2}}}, valid: true, detail: true},
+		{name: "valid line", geom: LineString{{0, 0}, {1, 1}}, valid: true, detail: true},
+		{name: "hole outside shell", geom: Polygon{shell, {{12, 12}, {14, 12}, {14, 14}, {12, 12}}}, valid: true},
+		{name: "hole crosses shell", geom: Polygon{shell, {{5, 5}, {15, 5}, {15, 6}, {5, 5}}}, valid: true},
+		{name: "disconnected interior", geom: Polygon{shell, {{0, 5}, {5, 0}, {10, 5}, {5, 10}, {0, 5}}}, valid: true},
+		{name: "nested shells", geom: MultiPolygon{{shell}, {{{2, 2}, {4, 2}, {4, 4}, {2, 2}}}}, valid: true},
+		{name: "nested holes", geom: Polygon{shell, {{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}, {{2, 2}, {3, 2}, {3, 3}, {2, 2}}},
+			valid: true},
+		{name: "adjacent polygons", geom: MultiPolygon{{shell}, {{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}}}, valid: true},
+		{name: "line of repeated points", geom: LineString{{0, 0}, {0, 0}}, valid: true},
+		{name: "collapsed polygon", geom: Polygon{{{0, 0}, {10, 0}, {0, 0}}}, valid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.geom.IsValid(); got != tt.valid {
+				t.Errorf("IsValid() = %v, want %v", got, tt.valid)
+			}
+			if got := tt.geom.IsValidDetail() == nil; got != tt.detail {
+				t.Errorf("IsValidDetail() == nil is %v, want %v", got, tt.detail)
+			}
+		})
+	}
+}
+
+func TestGeometry_IsValidReason(t *testing.T) {
+	tests := []struct {
+		name string
+		geom Geometry
+		want string
+	}{
+		{name: "valid", geom: Point{1, 1}, want: ValidReasonValid},
+		{name: "bow-tie", geom: Polygon{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}}, want: "Self-intersection[1 1]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.geom.IsValidReason(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IsValidReason() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}